@@ -0,0 +1,146 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	FlagActor     = "actor"
+	FlagRequestID = "request-id"
+	FlagPageSize  = "page-size"
+	FlagPageToken = "page-token"
+)
+
+func newAuditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "audit",
+		Short: "Read the audit log of relationship writes",
+	}
+}
+
+func NewListCmd() *cobra.Command {
+	var (
+		actor, requestID, pageToken string
+		pageSize                    int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the audit log",
+		Long: "List the recorded writes of relationships, the latest first.\n" +
+			"Returns paginated results.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			conn, err := client.GetWriteConn(cmd)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			resp, err := rts.NewAuditServiceClient(conn).ListAuditEntries(cmd.Context(), &rts.ListAuditEntriesRequest{
+				Actor:     actor,
+				RequestId: requestID,
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			out := &listOutput{
+				Entries:       make([]*ketoapi.AuditEntry, len(resp.Entries)),
+				IsLastPage:    resp.NextPageToken == "",
+				NextPageToken: resp.NextPageToken,
+			}
+			for i, e := range resp.Entries {
+				out.Entries[i], err = (&ketoapi.AuditEntry{}).FromProto(e)
+				if err != nil {
+					return err
+				}
+			}
+			cmdx.PrintTable(cmd, out)
+			return nil
+		},
+	}
+
+	client.RegisterRemoteURLFlags(cmd.Flags())
+	cmdx.RegisterFormatFlags(cmd.Flags())
+
+	cmd.Flags().StringVar(&actor, FlagActor, "", "only list the writes of this actor")
+	cmd.Flags().StringVar(&requestID, FlagRequestID, "", "only list the writes of this request")
+	cmd.Flags().StringVar(&pageToken, FlagPageToken, "", "page token acquired from a previous response")
+	cmd.Flags().Int32Var(&pageSize, FlagPageSize, 100, "maximum number of items to return")
+
+	return cmd
+}
+
+func RegisterCommandsRecursive(parent *cobra.Command) {
+	auditCmd := newAuditCmd()
+	parent.AddCommand(auditCmd)
+
+	auditCmd.AddCommand(NewListCmd())
+}
+
+type listOutput struct {
+	Entries       []*ketoapi.AuditEntry `json:"entries"`
+	IsLastPage    bool                  `json:"is_last_page"`
+	NextPageToken string                `json:"next_page_token"`
+}
+
+var _ cmdx.Table = (*listOutput)(nil)
+
+func (o *listOutput) Header() []string {
+	return []string{"TIME", "OPERATION", "ACTOR", "REQUEST ID", "CHANGES"}
+}
+
+func (o *listOutput) Table() [][]string {
+	data := make([][]string, 0, len(o.Entries)+3)
+	for _, e := range o.Entries {
+		changes := make([]string, len(e.Deltas))
+		for i, d := range e.Deltas {
+			changes[i] = fmt.Sprintf("%s %s", d.Action, d.RelationTuple)
+		}
+		data = append(data, []string{
+			e.Time.Format(time.RFC3339Nano),
+			e.Operation,
+			e.Actor,
+			e.RequestID,
+			strings.Join(changes, ", "),
+		})
+	}
+	return append(data,
+		[]string{},
+		[]string{"NEXT PAGE TOKEN", o.NextPageToken},
+		[]string{"IS LAST PAGE", strconv.FormatBool(o.IsLastPage)},
+	)
+}
+
+func (o *listOutput) Interface() interface{} {
+	return o
+}
+
+func (o *listOutput) Len() int {
+	return len(o.Entries) + 3
+}
+
+func (o *listOutput) IDs() []string {
+	ids := make([]string, len(o.Entries))
+	for i, e := range o.Entries {
+		ids[i] = e.RequestID
+	}
+	return ids
+}
@@ -46,6 +46,10 @@ func NewCheckCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			asOf, err := client.GetAsOf(cmd)
+			if err != nil {
+				return err
+			}
 
 			cl := rts.NewCheckServiceClient(conn)
 
@@ -62,6 +66,7 @@ func NewCheckCmd() *cobra.Command {
 					Subject:   sub,
 				},
 				MaxDepth: maxDepth,
+				AsOf:     asOf,
 			})
 			if err != nil {
 				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
@@ -74,6 +79,7 @@ func NewCheckCmd() *cobra.Command {
 	}
 
 	client.RegisterRemoteURLFlags(cmd.Flags())
+	client.RegisterAsOfFlag(cmd.Flags())
 	cmdx.RegisterFormatFlags(cmd.Flags())
 	cmd.Flags().Int32P(FlagMaxDepth, "d", 0, "Maximum depth of the search tree. If the value is less than 1 or greater than the global max-depth then the global max-depth will be used instead.")
 
@@ -0,0 +1,43 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/helpers"
+	"github.com/ory/keto/ketoctx"
+)
+
+func newChangelogCmd(opts []ketoctx.Option) *cobra.Command {
+	return &cobra.Command{
+		Use:   "changelog",
+		Short: "Delete old changes from the changelog",
+		Long: "Delete the changes that are older than the watch.retention config from the changelog of the Watch API.\n" +
+			"Changes that are referenced by the audit log are kept. " +
+			"Watchers that resume from a cursor before the deleted changes get an error.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			reg, err := helpers.NewRegistry(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			before := time.Now().Add(-reg.Config(ctx).WatchRetention())
+			n, err := reg.Persister().PruneRelationTupleChanges(ctx, before)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not delete the changes: %v\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Deleted %d changes committed before %s.\n", n, before.UTC().Format(time.RFC3339))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/helpers"
+	"github.com/ory/keto/ketoctx"
+)
+
+func newHistoryCmd(opts []ketoctx.Option) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "Purge deleted relationships from the history",
+		Long: "Purge the relationships that were deleted before the history.retention config. " +
+			"They are kept in the history mode to read relationships as of a past time, " +
+			"but reads before the retention window fail anyway.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			reg, err := helpers.NewRegistry(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			before := time.Now().Add(-reg.Config(ctx).HistoryRetention())
+			n, err := reg.Persister().PurgeRelationTupleTombstones(ctx, before)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not purge the deleted relationships: %v\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Purged %d relationships deleted before %s.\n", n, before.UTC().Format(time.RFC3339))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/configx"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x/dbx"
+)
+
+func TestHistoryCmd(t *testing.T) {
+	ctx := context.Background()
+	dsn := dbx.GetSqlite(t, dbx.SQLiteMemory)
+	reg := driver.NewTestRegistry(t, dsn)
+	require.NoError(t, reg.MigrateUp(ctx))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, true))
+
+	cmd := &cmdx.CommandExecuter{
+		New: func() *cobra.Command {
+			cmd := newCleanupCmd(nil)
+			configx.RegisterFlags(cmd.PersistentFlags())
+			return cmd
+		},
+		Ctx: ctx,
+		PersistentArgs: []string{"-c", dbx.ConfigFile(t, map[string]interface{}{
+			config.KeyDSN:              dsn.Conn,
+			config.KeyNamespaces:       []string{},
+			config.KeyHistoryEnabled:   true,
+			config.KeyHistoryRetention: "1ms",
+		})},
+	}
+
+	deleted, kept := &relationtuple.RelationTuple{
+		Namespace: "n",
+		Object:    uuid.Must(uuid.NewV4()),
+		Relation:  "r",
+		Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+	}, &relationtuple.RelationTuple{
+		Namespace: "n",
+		Object:    uuid.Must(uuid.NewV4()),
+		Relation:  "r",
+		Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+	}
+	require.NoError(t, reg.Persister().WriteRelationTuples(ctx, deleted, kept))
+	require.NoError(t, reg.Persister().DeleteRelationTuples(ctx, deleted))
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Contains(t, cmd.ExecNoErr(t, "history"), "Purged 1 relationships")
+
+	// only the tombstone was purged
+	assert.Contains(t, cmd.ExecNoErr(t, "history"), "Purged 0 relationships")
+	res, _, err := reg.Persister().GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []*relationtuple.RelationTuple{kept}, res)
+}
@@ -0,0 +1,28 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cleanup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/ketoctx"
+)
+
+func newCleanupCmd(opts []ketoctx.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Commands to delete old data from the database",
+		Long: "Commands to delete old data from the database.\n" +
+			"They connect to the database directly, and should run periodically, e.g. as a cron job.",
+	}
+	cmd.AddCommand(
+		newChangelogCmd(opts),
+		newHistoryCmd(opts),
+	)
+	return cmd
+}
+
+func RegisterCommandsRecursive(parent *cobra.Command, opts []ketoctx.Option) {
+	parent.AddCommand(newCleanupCmd(opts))
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const FlagAsOf = "as-of"
+
+func RegisterAsOfFlag(flags *pflag.FlagSet) {
+	flags.String(FlagAsOf, "", "Read the relationships as they were at this time, in RFC 3339 format. Requires the history mode of the server.")
+}
+
+// GetAsOf returns the time of the as-of flag, or nil if it is not set.
+func GetAsOf(cmd *cobra.Command) (*timestamppb.Timestamp, error) {
+	v, err := cmd.Flags().GetString(FlagAsOf)
+	if err != nil || v == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse --%s as RFC 3339 timestamp: %w", FlagAsOf, err)
+	}
+	return timestamppb.New(t), nil
+}
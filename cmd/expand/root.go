@@ -37,11 +37,16 @@ func NewExpandCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			asOf, err := client.GetAsOf(cmd)
+			if err != nil {
+				return err
+			}
 
 			cl := rts.NewExpandServiceClient(conn)
 			resp, err := cl.Expand(cmd.Context(), &rts.ExpandRequest{
 				Subject:  rts.NewSubjectSet(args[1], args[2], args[0]),
 				MaxDepth: maxDepth,
+				AsOf:     asOf,
 			})
 			if err != nil {
 				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Error making the request: %s\n", err.Error())
@@ -66,6 +71,7 @@ func NewExpandCmd() *cobra.Command {
 	}
 
 	client.RegisterRemoteURLFlags(cmd.Flags())
+	client.RegisterAsOfFlag(cmd.Flags())
 	cmdx.RegisterJSONFormatFlags(cmd.Flags())
 	cmdx.RegisterNoiseFlags(cmd.Flags())
 	cmd.Flags().Int32P(FlagMaxDepth, "d", 0, "Maximum depth of the tree to be returned. If the value is less than 1 or greater than the global max-depth then the global max-depth will be used instead.")
@@ -0,0 +1,55 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/ketoctx"
+)
+
+func NewOPLCmd(opts []ketoctx.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opl",
+		Short: "Work with Ory Permission Language files",
+	}
+	cmd.AddCommand(
+		NewOPLDiffCmd(opts),
+		NewOPLTestCmd(opts),
+		NewOPLCodegenCmd(),
+		NewOPLGenerateCmd(opts),
+	)
+	return cmd
+}
+
+// parseOPLFile reads and parses the OPL file. Parse errors are printed to
+// stderr.
+func parseOPLFile(cmd *cobra.Command, fn string) ([]*namespace.Namespace, error) {
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read file %s: %v\n", fn, err)
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	nn, errs := schema.Parse(string(content))
+	if len(errs) > 0 {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not parse %s:\n", fn)
+		for _, e := range errs {
+			_, _ = fmt.Fprintln(cmd.ErrOrStderr(), e.Error())
+		}
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+	return namespaces, nil
+}
@@ -0,0 +1,244 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ory/x/flagx"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/internal/namespace"
+)
+
+//go:embed codegen_template/*
+var codegenTemplate embed.FS
+
+const (
+	FlagLang    = "lang"
+	FlagPackage = "package"
+
+	LangGo         = "go"
+	LangTypeScript = "ts"
+)
+
+type (
+	codegenData struct {
+		Package    string
+		Namespaces []*codegenNamespace
+	}
+	codegenNamespace struct {
+		Name, Ident string
+		// Const is the name of the Go constant of the namespace name, e.g.
+		// "NamespaceDocument".
+		Const     string
+		Relations []*codegenRelation
+		// SubjectSets are the relations that other relations reference in a
+		// subject set type.
+		SubjectSets []*codegenRelation
+	}
+	codegenRelation struct {
+		Name, Ident string
+		IsPermit    bool
+		// Const is the name of the Go constant of the relation name, e.g.
+		// "DocumentViewers".
+		Const string
+		// CheckMethod is the name of the method that checks the relation or
+		// permit, e.g. "HasOwners" or "CanView".
+		CheckMethod                            string
+		AddMethod, RemoveMethod, SubjectMethod string
+	}
+	// identifiers hands out unique identifiers in one scope.
+	identifiers map[string]bool
+)
+
+var (
+	// topLevelIdents are declared by the templates themselves, so namespaces
+	// and relations must not use them.
+	topLevelIdents = []string{"Client", "NewClient", "Subject", "SubjectID", "Namespaces"}
+	// objectIdents are the fields and methods of every object type.
+	objectIdents = []string{"ID", "Subject"}
+)
+
+func NewOPLCodegenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codegen <namespaces.ts>",
+		Short: "Generate a typed client from an OPL file",
+		Long: "Generate a typed client from an OPL file and write it to stdout.\n" +
+			"The client has a type for every namespace, with methods to check permits, and to check, add, and remove relationships. " +
+			"It uses the gRPC check and write services. " +
+			"Identifiers that collide with the ones of the client or with each other are escaped with trailing underscores.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespaces, err := parseOPLFile(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			return GenerateClient(flagx.MustGetString(cmd, FlagLang), flagx.MustGetString(cmd, FlagPackage), namespaces, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(FlagLang, LangGo, fmt.Sprintf("The language of the generated client, one of %q or %q.", LangGo, LangTypeScript))
+	cmd.Flags().String(FlagPackage, "keto", "The package name of the generated Go client.")
+
+	return cmd
+}
+
+// GenerateClient generates a typed client in the given language for the
+// namespaces and writes it to out.
+func GenerateClient(lang, pkg string, namespaces []*namespace.Namespace, out io.Writer) error {
+	var tmpl string
+	switch lang {
+	case LangGo:
+		tmpl = "client.go.tmpl"
+	case LangTypeScript:
+		tmpl = "client.ts.tmpl"
+	default:
+		return errors.Errorf("unknown language %q, expected %q or %q", lang, LangGo, LangTypeScript)
+	}
+
+	t, err := template.New("codegen_template").
+		Funcs(template.FuncMap{"lowerFirst": lowerFirst}).
+		ParseFS(codegenTemplate, "codegen_template/*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, tmpl, newCodegenData(pkg, namespaces)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	res := buf.Bytes()
+	if lang == LangGo {
+		if res, err = format.Source(res); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	_, err = out.Write(res)
+	return errors.WithStack(err)
+}
+
+func newCodegenData(pkg string, namespaces []*namespace.Namespace) *codegenData {
+	// collect the relations that are referenced as subject sets
+	subjectSets := make(map[string]map[string]struct{})
+	for _, n := range namespaces {
+		for _, r := range n.Relations {
+			for _, t := range r.Types {
+				if t.Relation == "" {
+					continue
+				}
+				if subjectSets[t.Namespace] == nil {
+					subjectSets[t.Namespace] = make(map[string]struct{})
+				}
+				subjectSets[t.Namespace][t.Relation] = struct{}{}
+			}
+		}
+	}
+
+	namespaces = append([]*namespace.Namespace{}, namespaces...)
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+
+	// The identifiers are handed out in a fixed order, so that the ones of
+	// the types win over the derived constants.
+	topLevel := newIdentifiers(topLevelIdents...)
+	data := &codegenData{Package: pkg}
+	for _, n := range namespaces {
+		data.Namespaces = append(data.Namespaces, &codegenNamespace{Name: n.Name, Ident: topLevel.unique(identifier(n.Name))})
+	}
+	for _, cn := range data.Namespaces {
+		cn.Const = topLevel.unique("Namespace" + cn.Ident)
+	}
+	for i, n := range namespaces {
+		cn := data.Namespaces[i]
+		relations, methods := newIdentifiers(), newIdentifiers(objectIdents...)
+		for _, r := range n.Relations {
+			cr := &codegenRelation{Name: r.Name, Ident: relations.unique(identifier(r.Name)), IsPermit: r.SubjectSetRewrite != nil}
+			cr.Const = topLevel.unique(cn.Ident + cr.Ident)
+			cr.CheckMethod = methods.unique(checkMethod(cr))
+			if !cr.IsPermit {
+				cr.AddMethod = methods.unique("Add" + cr.Ident)
+				cr.RemoveMethod = methods.unique("Remove" + cr.Ident)
+			}
+			cn.Relations = append(cn.Relations, cr)
+			if _, ok := subjectSets[n.Name][r.Name]; ok {
+				cr.SubjectMethod = methods.unique(cr.Ident + "Subject")
+				cn.SubjectSets = append(cn.SubjectSets, cr)
+			}
+		}
+	}
+	return data
+}
+
+func newIdentifiers(reserved ...string) identifiers {
+	ids := make(identifiers, len(reserved))
+	for _, id := range reserved {
+		ids[id] = true
+	}
+	return ids
+}
+
+// unique returns the identifier, or escapes it with trailing underscores if it
+// is taken already.
+func (ids identifiers) unique(ident string) string {
+	for ids[ident] {
+		ident += "_"
+	}
+	ids[ident] = true
+	return ident
+}
+
+// identifier converts a namespace or relation name to an exported identifier,
+// e.g. "can_view" to "CanView". Names that do not start with a letter, e.g.
+// "_1", are prefixed with "X".
+func identifier(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		switch {
+		case r == '_' || r == '-' || r == '$':
+			upper = true
+		case upper:
+			sb.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if ident := sb.String(); ident != "" && unicode.IsLetter([]rune(ident)[0]) {
+		return ident
+	}
+	return "X" + sb.String()
+}
+
+func checkMethod(r *codegenRelation) string {
+	if !r.IsPermit {
+		return "Has" + r.Ident
+	}
+	// avoid "CanCanView" for permits like "can_view"
+	if rest := strings.TrimPrefix(r.Ident, "Can"); rest != r.Ident && rest != "" && unicode.IsUpper([]rune(rest)[0]) {
+		return r.Ident
+	}
+	return "Can" + r.Ident
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ory/x/snapshotx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/cmd/namespace"
+	ns "github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/schema"
+)
+
+func TestGenerateClient(t *testing.T) {
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class user_group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<user_group, "members">)[]
+  }
+  permits = {
+    can_view: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`)
+	require.Len(t, errs, 0)
+	namespaces := make([]*ns.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+
+	for _, lang := range []string{namespace.LangGo, namespace.LangTypeScript} {
+		t.Run("lang="+lang, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, namespace.GenerateClient(lang, "keto", namespaces, &out))
+			snapshotx.SnapshotT(t, out.String())
+		})
+	}
+
+	t.Run("lang=unknown", func(t *testing.T) {
+		err := namespace.GenerateClient("rust", "keto", namespaces, &bytes.Buffer{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown language")
+	})
+}
+
+func TestGenerateClientCompiles(t *testing.T) {
+	// the names collide with the declarations of the template, and with the
+	// identifiers that are derived from other names
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class Client implements Namespace {
+  related: {
+    subject: User[]
+    owners: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.owners.includes(ctx.subject),
+    can_view: (ctx: Context) => this.related.owners.includes(ctx.subject),
+  }
+}
+class Subject implements Namespace {
+  related: {
+    id: (User | SubjectSet<Client, "owners">)[]
+    ID: User[]
+  }
+}
+class SubjectID implements Namespace {}
+class NamespaceUser implements Namespace {}
+class ClientOwners implements Namespace {}
+class _1 implements Namespace {}
+`)
+	require.Len(t, errs, 0)
+	namespaces := make([]*ns.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, namespace.GenerateClient(namespace.LangGo, "keto", namespaces, &out))
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "client.go", out.Bytes(), parser.ParseComments)
+	require.NoError(t, err)
+
+	// type-check against the export data of the imported packages, which the
+	// go command builds
+	imports := make([]string, len(f.Imports))
+	for i, spec := range f.Imports {
+		imports[i], err = strconv.Unquote(spec.Path.Value)
+		require.NoError(t, err)
+	}
+	list, err := exec.Command("go", append([]string{"list", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}"}, imports...)...).Output()
+	require.NoError(t, err)
+	exports := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(list)), "\n") {
+		path, export, _ := strings.Cut(line, "=")
+		exports[path] = export
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		return os.Open(exports[path])
+	})}
+	_, err = conf.Check("keto", fset, []*ast.File{f}, nil)
+	require.NoError(t, err, "%s", out.String())
+}
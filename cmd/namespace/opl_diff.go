@@ -0,0 +1,102 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/helpers"
+	"github.com/ory/keto/internal/namespace/namespacediff"
+	"github.com/ory/keto/ketoctx"
+)
+
+const FlagOffline = "offline"
+
+type changeTable []*namespacediff.Change
+
+func NewOPLDiffCmd(opts []ketoctx.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <old.ts> <new.ts>",
+		Short: "Show the changes between two OPL files",
+		Long: "Show the namespaces, relations, and permits that were added, removed, or retyped between two OPL files.\n" +
+			"Connects to the configured database to count the stored relationships that the new schema would orphan, " +
+			"unless --" + FlagOffline + " is set.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			old, err := parseOPLFile(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			updated, err := parseOPLFile(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			changes := namespacediff.Compute(old, updated)
+
+			if !flagx.MustGetBool(cmd, FlagOffline) {
+				reg, err := helpers.NewRegistry(cmd, opts)
+				if err != nil {
+					return err
+				}
+				counts, err := reg.Persister().CountSubjectTypes(cmd.Context())
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not count the stored relationships: %v\n", err)
+					return cmdx.FailSilently(cmd)
+				}
+				namespacediff.CountOrphans(changes, counts)
+			}
+
+			cmdx.PrintTable(cmd, changeTable(changes))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagOffline, false, "Do not connect to the database to count orphaned relationships.")
+	cmdx.RegisterFormatFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (t changeTable) Header() []string {
+	return []string{"CHANGE", "KIND", "NAMESPACE", "RELATION", "TYPES", "BREAKING", "ORPHANED TUPLES"}
+}
+
+func (t changeTable) Table() [][]string {
+	rows := make([][]string, len(t))
+	for i, c := range t {
+		var types string
+		switch c.Type {
+		case namespacediff.ChangeAdded:
+			types = namespacediff.FormatTypes(c.NewTypes)
+		case namespacediff.ChangeRemoved:
+			types = namespacediff.FormatTypes(c.OldTypes)
+		case namespacediff.ChangeRetyped:
+			types = namespacediff.FormatTypes(c.OldTypes) + " -> " + namespacediff.FormatTypes(c.NewTypes)
+		}
+		rows[i] = []string{
+			string(c.Type),
+			string(c.Kind),
+			c.Namespace,
+			c.Relation,
+			types,
+			strconv.FormatBool(c.IsBreaking()),
+			strconv.Itoa(c.OrphanedTuples),
+		}
+	}
+	return rows
+}
+
+func (t changeTable) Interface() interface{} {
+	return []*namespacediff.Change(t)
+}
+
+func (t changeTable) Len() int {
+	return len(t)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOPLDiffCmd(t *testing.T) {
+	cmd := cmdx.CommandExecuter{New: func() *cobra.Command { return NewOPLDiffCmd(nil) }}
+
+	dir := t.TempDir()
+	oldFn, newFn, invalidFn := filepath.Join(dir, "old.ts"), filepath.Join(dir, "new.ts"), filepath.Join(dir, "invalid.ts")
+	require.NoError(t, os.WriteFile(oldFn, []byte(`
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    viewers: User[]
+    parents: Document[]
+  }
+}
+`), fileMode))
+	require.NoError(t, os.WriteFile(newFn, []byte(`
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    viewers: User[]
+  }
+}
+`), fileMode))
+	require.NoError(t, os.WriteFile(invalidFn, []byte(`class Document implements Namespace {`), fileMode))
+
+	t.Run("case=reports changes", func(t *testing.T) {
+		out := cmd.ExecNoErr(t, "--"+FlagOffline, "--"+cmdx.FlagFormat, string(cmdx.FormatJSON), oldFn, newFn)
+
+		var changes []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(out), &changes))
+		require.Len(t, changes, 1)
+		assert.Equal(t, "removed", changes[0]["type"])
+		assert.Equal(t, "Document", changes[0]["namespace"])
+		assert.Equal(t, "parents", changes[0]["relation"])
+	})
+
+	t.Run("case=fails on parse errors", func(t *testing.T) {
+		stdErr := cmd.ExecExpectedErr(t, "--"+FlagOffline, oldFn, invalidFn)
+		assert.Contains(t, stdErr, "Could not parse")
+	})
+}
@@ -5,15 +5,89 @@ package namespace
 
 import (
 	"embed"
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"text/template"
 
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/helpers"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoctx"
 )
 
 //go:embed config_template/*
 var configTemplate embed.FS
 
+const (
+	FlagFromTuples         = "from-tuples"
+	FlagSubjectIDNamespace = "subject-id-namespace"
+)
+
+type (
+	inferredNamespace struct {
+		Name      string
+		Relations []*inferredRelation
+	}
+	inferredRelation struct {
+		Name, Types string
+		Comments    []string
+	}
+)
+
+func NewOPLGenerateCmd(opts []ketoctx.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [<namespace>...]",
+		Short: "Generate an OPL file",
+		Long: "Generate an OPL file and write it to stdout.\n" +
+			"Without --" + FlagFromTuples + ", an empty class is generated for every namespace given as an argument.\n" +
+			"With --" + FlagFromTuples + ", the configured database is scanned, and every relation gets the subject types of its stored relationships. " +
+			"This helps to migrate from the legacy namespace config.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flagx.MustGetBool(cmd, FlagFromTuples) {
+				if len(args) == 0 {
+					return errors.New("expected at least one namespace, or --" + FlagFromTuples)
+				}
+				return GenerateOPLConfig(args, cmd.OutOrStdout())
+			}
+
+			reg, err := helpers.NewRegistry(cmd, opts)
+			if err != nil {
+				return err
+			}
+			counts, err := reg.Persister().CountSubjectTypes(cmd.Context())
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not scan the stored relationships: %v\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			// also include the configured namespaces that have no relationships
+			namespaces := args
+			if nm, err := reg.Config(cmd.Context()).NamespaceManager(); err == nil {
+				nn, err := nm.Namespaces(cmd.Context())
+				if err != nil {
+					return err
+				}
+				for _, n := range nn {
+					namespaces = append(namespaces, n.Name)
+				}
+			}
+
+			return InferOPLConfig(namespaces, counts, flagx.MustGetString(cmd, FlagSubjectIDNamespace), cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().Bool(FlagFromTuples, false, "Infer the relations and their subject types from the stored relationships.")
+	cmd.Flags().String(FlagSubjectIDNamespace, "User", "The namespace that subject IDs are typed as, since they have no namespace.")
+
+	return cmd
+}
+
 // GenerateOPLConfig derives an Ory Permission Language config from the
 // namespaces and writes it to out. The OPL config is functionally equivalent to
 // the list of namespaces.
@@ -26,3 +100,109 @@ func GenerateOPLConfig(namespaces []string, out io.Writer) error {
 		"namespaces.ts.tmpl",
 		struct{ Namespaces []string }{Namespaces: namespaces}))
 }
+
+// InferOPLConfig derives an Ory Permission Language config from the subject
+// types of the stored relationships and writes it to out. Every relation is
+// typed with the subject types it has relationships with. Subject IDs are
+// typed as subjectIDNamespace. Relations that are only referenced by subject
+// sets are declared as well, so that the config type checks.
+func InferOPLConfig(namespaces []string, counts []*relationtuple.SubjectTypeCount, subjectIDNamespace string, out io.Writer) error {
+	type relation struct {
+		types      map[string]struct{}
+		subjectIDs int
+	}
+	byNamespace := make(map[string]map[string]*relation)
+	addNamespace := func(n string) map[string]*relation {
+		if byNamespace[n] == nil {
+			byNamespace[n] = make(map[string]*relation)
+		}
+		return byNamespace[n]
+	}
+	addRelation := func(n, r string) *relation {
+		rels := addNamespace(n)
+		if rels[r] == nil {
+			rels[r] = &relation{types: make(map[string]struct{})}
+		}
+		return rels[r]
+	}
+
+	for _, n := range namespaces {
+		addNamespace(n)
+	}
+	for _, c := range counts {
+		r := addRelation(c.Namespace, c.Relation)
+		switch {
+		case c.IsSubjectID():
+			r.subjectIDs += c.Count
+			addNamespace(subjectIDNamespace)
+		case c.SubjectSetRelation == "":
+			r.types[c.SubjectSetNamespace] = struct{}{}
+			addNamespace(c.SubjectSetNamespace)
+		default:
+			r.types[fmt.Sprintf("SubjectSet<%s, %q>", c.SubjectSetNamespace, c.SubjectSetRelation)] = struct{}{}
+		}
+	}
+	// declare the relations of stored subject sets
+	referenced := make(map[[2]string]bool)
+	for _, c := range counts {
+		if c.SubjectSetRelation == "" {
+			continue
+		}
+		if _, ok := byNamespace[c.SubjectSetNamespace][c.SubjectSetRelation]; !ok {
+			addRelation(c.SubjectSetNamespace, c.SubjectSetRelation)
+			referenced[[2]string{c.SubjectSetNamespace, c.SubjectSetRelation}] = true
+		}
+	}
+
+	data := struct{ Namespaces []*inferredNamespace }{}
+	for n, rels := range byNamespace {
+		in := &inferredNamespace{Name: n}
+		for name, r := range rels {
+			ir := &inferredRelation{Name: name}
+			types := make([]string, 0, len(r.types)+1)
+			for t := range r.types {
+				types = append(types, t)
+			}
+			if r.subjectIDs > 0 {
+				types = append(types, subjectIDNamespace)
+				ir.Comments = append(ir.Comments, fmt.Sprintf(
+					"%d relationships have subject IDs, which are typed as %s here.", r.subjectIDs, subjectIDNamespace))
+			}
+			if referenced[[2]string{n, name}] {
+				types = append(types, subjectIDNamespace)
+				ir.Comments = append(ir.Comments, "Referenced by stored subject sets, but has no relationships.")
+			}
+			ir.Types = formatInferredTypes(types)
+			in.Relations = append(in.Relations, ir)
+		}
+		sort.Slice(in.Relations, func(i, j int) bool {
+			return in.Relations[i].Name < in.Relations[j].Name
+		})
+		data.Namespaces = append(data.Namespaces, in)
+	}
+	sort.Slice(data.Namespaces, func(i, j int) bool {
+		return data.Namespaces[i].Name < data.Namespaces[j].Name
+	})
+
+	t, err := template.New("config_template").ParseFS(configTemplate, "config_template/*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(t.ExecuteTemplate(out, "inferred.ts.tmpl", &data))
+}
+
+// formatInferredTypes formats the types as an OPL relation type, with the
+// namespaces first and the subject sets last.
+func formatInferredTypes(types []string) string {
+	sort.Slice(types, func(i, j int) bool {
+		iSet, jSet := strings.HasPrefix(types[i], "SubjectSet<"), strings.HasPrefix(types[j], "SubjectSet<")
+		if iSet != jSet {
+			return jSet
+		}
+		return types[i] < types[j]
+	})
+	if len(types) == 1 {
+		return types[0] + "[]"
+	}
+	return "(" + strings.Join(types, " | ") + ")[]"
+}
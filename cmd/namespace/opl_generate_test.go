@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ory/keto/cmd/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/schema"
 )
 
 func TestGenerateOPLConfig(t *testing.T) {
@@ -36,3 +38,34 @@ func TestGenerateOPLConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestInferOPLConfig(t *testing.T) {
+	cases := []struct {
+		name       string
+		namespaces []string
+		counts     []*relationtuple.SubjectTypeCount
+	}{{
+		name:       "no relationships",
+		namespaces: []string{"Document", "Group"},
+	}, {
+		name: "subject types",
+		counts: []*relationtuple.SubjectTypeCount{
+			{Namespace: "Document", Relation: "viewers", Count: 3},
+			{Namespace: "Document", Relation: "viewers", SubjectSetNamespace: "Group", SubjectSetRelation: "members", Count: 2},
+			{Namespace: "Document", Relation: "parents", SubjectSetNamespace: "Folder", Count: 1},
+			{Namespace: "Folder", Relation: "viewers", SubjectSetNamespace: "Team", SubjectSetRelation: "owners", Count: 1},
+			{Namespace: "Group", Relation: "members", Count: 5},
+		},
+	}}
+
+	for _, tc := range cases {
+		t.Run("case="+tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, namespace.InferOPLConfig(tc.namespaces, tc.counts, "User", &out))
+			snapshotx.SnapshotT(t, out.String())
+
+			_, errs := schema.Parse(out.String())
+			require.Empty(t, errs)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ory/x/cmdx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/opltest"
+	"github.com/ory/keto/ketoctx"
+)
+
+func NewOPLTestCmd(opts []ketoctx.Option) *cobra.Command {
+	return &cobra.Command{
+		Use:   "test <namespaces.ts> <test-file>...",
+		Short: "Run declarative tests against an OPL file",
+		Long: "Run declarative tests against an OPL file, without connecting to a database.\n" +
+			"A test file contains fixture relationships in the format of `keto relation-tuple parse`, and assertions like\n\n" +
+			"    Document:readme#view@User:bob is allowed\n" +
+			"    Document:readme#view@User:alice is denied\n\n" +
+			"Every test file is evaluated against its own empty in-memory store. " +
+			"Comments (lines starting with `//`) and blank lines are ignored.",
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespaces, err := parseOPLFile(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			var passed, failed int
+			for _, fn := range args[1:] {
+				content, err := os.ReadFile(fn)
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read file %s: %v\n", fn, err)
+					return cmdx.FailSilently(cmd)
+				}
+				suite, err := opltest.Parse(fn, string(content))
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%v\n", err)
+					return cmdx.FailSilently(cmd)
+				}
+
+				results, err := runSuite(cmd.Context(), namespaces, opts, suite)
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%v\n", err)
+					return cmdx.FailSilently(cmd)
+				}
+
+				for _, r := range results {
+					if r.Passed {
+						passed++
+					} else {
+						failed++
+					}
+					printResult(cmd, fn, r)
+				}
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "\n%d passed, %d failed\n", passed, failed)
+			if failed > 0 {
+				return cmdx.FailSilently(cmd)
+			}
+			return nil
+		},
+	}
+}
+
+// runSuite runs the test suite against a new in-memory registry, which is
+// closed afterwards to discard its database.
+func runSuite(ctx context.Context, namespaces []*namespace.Namespace, opts []ketoctx.Option, suite *opltest.Suite) (_ []*opltest.Result, err error) {
+	reg, err := driver.NewInMemoryRegistry(ctx, namespaces, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := reg.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return opltest.Run(ctx, reg, suite)
+}
+
+func printResult(cmd *cobra.Command, fn string, r *opltest.Result) {
+	status, expected := "PASS", "denied"
+	if !r.Passed {
+		status = "FAIL"
+	}
+	if r.Allowed {
+		expected = "allowed"
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s %s:%d %s is %s\n", status, fn, r.Line, r.Tuple, expected)
+
+	switch {
+	case r.Err != nil:
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "    error: %v\n", r.Err)
+	case r.Tree != nil:
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "    %s\n", strings.ReplaceAll(r.Tree.String(), "\n", "\n    "))
+	case !r.Passed:
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "    no relationships found")
+	}
+}
@@ -16,9 +16,9 @@ func NewNamespaceCmd() *cobra.Command {
 	}
 }
 
-func RegisterCommandsRecursive(parent *cobra.Command, _ []ketoctx.Option) {
+func RegisterCommandsRecursive(parent *cobra.Command, opts []ketoctx.Option) {
 	rootCmd := NewNamespaceCmd()
-	rootCmd.AddCommand(NewValidateCmd())
+	rootCmd.AddCommand(NewValidateCmd(), NewOPLCmd(opts))
 
 	parent.AddCommand(rootCmd)
 }
@@ -76,6 +76,10 @@ func readTuplesFromArg(cmd *cobra.Command, arg string) ([]*ketoapi.RelationTuple
 		return nil, cmdx.FailSilently(cmd)
 	}
 
+	return decodeTuples(cmd, fc)
+}
+
+func decodeTuples(cmd *cobra.Command, fc []byte) ([]*ketoapi.RelationTuple, error) {
 	decoder := json.NewDecoder(bytes.NewReader(fc))
 	decoder.DisallowUnknownFields()
 	// it is ok to not validate beforehand because json.Unmarshal will report errors
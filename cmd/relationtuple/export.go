@@ -0,0 +1,95 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const FlagCursor = "cursor"
+
+func NewExportCmd() *cobra.Command {
+	var (
+		namespace, cursor string
+		pageSize          int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all relationships of a namespace",
+		Long: "Export all relationships of a namespace, one per line, in a format that \"relation-tuple import\" reads.\n" +
+			"If the export is interrupted, it can be resumed with the printed cursor.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format := flagx.MustGetString(cmd, FlagTupleFormat)
+			if format != TupleFormatNDJSON && format != TupleFormatParse {
+				return fmt.Errorf("unknown tuple format %q, expected %q or %q", format, TupleFormatNDJSON, TupleFormatParse)
+			}
+
+			conn, err := client.GetWriteConn(cmd)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			stream, err := rts.NewBulkServiceClient(conn).ExportRelationTuples(cmd.Context(), &rts.ExportRelationTuplesRequest{
+				Namespace: namespace,
+				Cursor:    cursor,
+				PageSize:  pageSize,
+			})
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				} else if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+					if cursor != "" {
+						_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Resume the export with --%s %s\n", FlagCursor, cursor)
+					}
+					return cmdx.FailSilently(cmd)
+				}
+
+				for _, rt := range resp.RelationTuples {
+					t, err := (&ketoapi.RelationTuple{}).FromDataProvider(rt)
+					if err != nil {
+						return err
+					}
+					if format == TupleFormatParse {
+						_, _ = fmt.Fprintln(cmd.OutOrStdout(), t.String())
+					} else if err := encoder.Encode(t); err != nil {
+						return err
+					}
+				}
+				cursor = resp.Cursor
+			}
+		},
+	}
+
+	client.RegisterRemoteURLFlags(cmd.Flags())
+	registerTupleFormatFlag(cmd.Flags())
+
+	cmd.Flags().StringVar(&namespace, FlagNamespace, "", "Set the namespace to export")
+	cmd.Flags().StringVar(&cursor, FlagCursor, "", "cursor of an interrupted export to resume it")
+	cmd.Flags().Int32Var(&pageSize, FlagPageSize, 1000, "maximum number of relationships per response")
+	_ = cmd.MarkFlagRequired(FlagNamespace)
+
+	return cmd
+}
@@ -89,6 +89,7 @@ func NewGetCmd() *cobra.Command {
 
 	registerPackageFlags(cmd.Flags())
 	registerRelationTupleFlags(cmd.Flags())
+	client.RegisterAsOfFlag(cmd.Flags())
 
 	cmd.Flags().StringVar(&pageToken, FlagPageToken, "", "page token acquired from a previous response")
 	cmd.Flags().Int32Var(&pageSize, FlagPageSize, 100, "maximum number of items to return")
@@ -113,11 +114,16 @@ func getTuples(pageSize *int32, pageToken *string) func(cmd *cobra.Command, _ []
 		if err != nil {
 			return err
 		}
+		asOf, err := client.GetAsOf(cmd)
+		if err != nil {
+			return err
+		}
 
 		resp, err := cl.ListRelationTuples(cmd.Context(), &rts.ListRelationTuplesRequest{
 			RelationQuery: query,
 			PageSize:      *pageSize,
 			PageToken:     *pageToken,
+			AsOf:          asOf,
 		})
 		if err != nil {
 			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
@@ -0,0 +1,165 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	FlagTupleFormat = "tuple-format"
+
+	TupleFormatNDJSON = "ndjson"
+	TupleFormatParse  = "parse"
+
+	// importMessageSize is the number of relationships sent per message.
+	importMessageSize = 1000
+	// maxImportLineSize is the maximum length of one line of an import.
+	maxImportLineSize = 1024 * 1024
+)
+
+func registerTupleFormatFlag(flags *pflag.FlagSet) {
+	flags.String(FlagTupleFormat, TupleFormatNDJSON, `Set the format of the relationships, one per line; one of "ndjson" or "parse" (as in "relation-tuple parse")`)
+}
+
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <relationships-file>...",
+		Short: "Import relationships in bulk",
+		Long: "Import large amounts of relationships from files with one relationship per line.\n" +
+			"The relationships are streamed to the server, which writes them in batches.\n" +
+			"If the import fails, the batches written before the failure stay written.\n" +
+			"Pass the special filename `-` to read from STD_IN.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format := flagx.MustGetString(cmd, FlagTupleFormat)
+			if format != TupleFormatNDJSON && format != TupleFormatParse {
+				return fmt.Errorf("unknown tuple format %q, expected %q or %q", format, TupleFormatNDJSON, TupleFormatParse)
+			}
+
+			conn, err := client.GetWriteConn(cmd)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			stream, err := rts.NewBulkServiceClient(conn).ImportRelationTuples(cmd.Context())
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			var batch []*rts.RelationTuple
+			send := func(t *ketoapi.RelationTuple) error {
+				batch = append(batch, t.ToProto())
+				if len(batch) < importMessageSize {
+					return nil
+				}
+				err := stream.Send(&rts.ImportRelationTuplesRequest{RelationTuples: batch})
+				batch = nil
+				return err
+			}
+			for _, fn := range args {
+				if err := readTupleLines(cmd, fn, format, send); err != nil {
+					if errors.Is(err, io.EOF) {
+						// The server closed the stream, CloseAndRecv returns its error.
+						break
+					}
+					return err
+				}
+			}
+			if len(batch) > 0 {
+				if err := stream.Send(&rts.ImportRelationTuplesRequest{RelationTuples: batch}); err != nil && !errors.Is(err, io.EOF) {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+					return cmdx.FailSilently(cmd)
+				}
+			}
+
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Imported %d relationships.\n", resp.Imported)
+			return nil
+		},
+	}
+
+	registerPackageFlags(cmd.Flags())
+	registerTupleFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+// readTupleLines decodes the relationships of the file line by line. Blank
+// lines and comments (lines starting with `//`) are ignored.
+func readTupleLines(cmd *cobra.Command, fn, format string, f func(*ketoapi.RelationTuple) error) error {
+	var r io.Reader
+	if fn == "-" {
+		// set human readable filename here for debug and error messages
+		fn = "stdin"
+		r = cmd.InOrStdin()
+	} else {
+		ff, err := os.Open(fn)
+		if err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not open file %s: %v\n", fn, err)
+			return cmdx.FailSilently(cmd)
+		}
+		defer ff.Close()
+		r = ff
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+	for i := 1; scanner.Scan(); i++ {
+		row := strings.TrimSpace(scanner.Text())
+		if row == "" || strings.HasPrefix(row, "//") {
+			continue
+		}
+
+		t, err := decodeTupleLine(row, format)
+		if err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not decode %s:%d\n  %s\n\n%v\n", fn, i, row, err)
+			return cmdx.FailSilently(cmd)
+		}
+		if err := f(t); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read file %s: %v\n", fn, err)
+		return cmdx.FailSilently(cmd)
+	}
+	return nil
+}
+
+func decodeTupleLine(row, format string) (*ketoapi.RelationTuple, error) {
+	if format == TupleFormatParse {
+		return (&ketoapi.RelationTuple{}).FromString(row)
+	}
+
+	var t ketoapi.RelationTuple
+	decoder := json.NewDecoder(bytes.NewReader([]byte(row)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
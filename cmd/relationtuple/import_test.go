@@ -0,0 +1,68 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/ketoapi"
+)
+
+func TestImportExportCmd(t *testing.T) {
+	ts := client.NewTestServer(t, client.WriteServer, []*namespace.Namespace{{Name: "n"}, {Name: "m"}}, NewImportCmd)
+	defer ts.Shutdown(t)
+
+	export := func(t *testing.T, args ...string) string {
+		return cmdx.ExecNoErrCtx(ts.Cmd.Ctx, t, NewExportCmd(), append(ts.Cmd.PersistentArgs, args...)...)
+	}
+
+	t.Run("format=parse", func(t *testing.T) {
+		in := "// a comment\nn:o#r@s\n\nn:o#r@m:o#r\n"
+		stdout, stderr, err := ts.Cmd.Exec(strings.NewReader(in), "--"+FlagTupleFormat, TupleFormatParse, "-")
+		require.NoError(t, err, stderr)
+		assert.Equal(t, "Imported 2 relationships.\n", stdout)
+
+		out := export(t, "--"+FlagNamespace, "n", "--"+FlagTupleFormat, TupleFormatParse)
+		assert.ElementsMatch(t, []string{"n:o#r@s", "n:o#r@m:o#r"}, strings.Fields(out))
+	})
+
+	t.Run("format=ndjson", func(t *testing.T) {
+		tuples := []*ketoapi.RelationTuple{
+			{Namespace: "m", Object: "a", Relation: "r", SubjectID: pointerx.Ptr("s")},
+			{Namespace: "m", Object: "b", Relation: "r", SubjectSet: &ketoapi.SubjectSet{Namespace: "n", Object: "o", Relation: "r"}},
+		}
+		var in bytes.Buffer
+		for _, rt := range tuples {
+			require.NoError(t, json.NewEncoder(&in).Encode(rt))
+		}
+		stdout, stderr, err := ts.Cmd.Exec(&in, "-")
+		require.NoError(t, err, stderr)
+		assert.Equal(t, "Imported 2 relationships.\n", stdout)
+
+		var exported []*ketoapi.RelationTuple
+		dec := json.NewDecoder(strings.NewReader(export(t, "--"+FlagNamespace, "m")))
+		for dec.More() {
+			var rt ketoapi.RelationTuple
+			require.NoError(t, dec.Decode(&rt))
+			exported = append(exported, &rt)
+		}
+		assert.ElementsMatch(t, tuples, exported)
+	})
+
+	t.Run("case=invalid line", func(t *testing.T) {
+		_, stderr, err := ts.Cmd.Exec(strings.NewReader("n:o#r@s\nnot a tuple\n"), "--"+FlagTupleFormat, TupleFormatParse, "-")
+		assert.ErrorIs(t, err, cmdx.ErrNoPrintButFail)
+		assert.Contains(t, stderr, "stdin:2")
+	})
+}
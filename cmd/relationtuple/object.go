@@ -0,0 +1,102 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"fmt"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/client"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const FlagNewObject = "new-object"
+
+func NewDeleteObjectCmd() *cobra.Command {
+	var namespace, object string
+
+	cmd := &cobra.Command{
+		Use:   "delete-object",
+		Short: "Delete all relationships that reference an object",
+		Long: "Delete all relationships of the object, and all relationships with a subject set of the object, in one transaction.\n" +
+			"The operation is not reversible, so it requires the `--force` flag.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !flagx.MustGetBool(cmd, FlagForce) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "WARNING: This operation is not reversible. Please use the `--%s` flag to proceed.\n", FlagForce)
+				return nil
+			}
+
+			conn, err := client.GetWriteConn(cmd)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			resp, err := rts.NewWriteServiceClient(conn).DeleteObject(cmd.Context(), &rts.DeleteObjectRequest{
+				Namespace: namespace,
+				Object:    object,
+			})
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Deleted %d relationships.\n", resp.Deleted)
+			return nil
+		},
+	}
+
+	registerPackageFlags(cmd.Flags())
+	cmd.Flags().StringVar(&namespace, FlagNamespace, "", "Set the namespace of the object")
+	cmd.Flags().StringVar(&object, FlagObject, "", "Set the object to delete")
+	cmd.Flags().Bool(FlagForce, false, "Force the deletion of relationships")
+	_ = cmd.MarkFlagRequired(FlagNamespace)
+	_ = cmd.MarkFlagRequired(FlagObject)
+
+	return cmd
+}
+
+func NewRenameObjectCmd() *cobra.Command {
+	var namespace, object, newObject string
+
+	cmd := &cobra.Command{
+		Use:   "rename-object",
+		Short: "Move all relationships that reference an object to a new object ID",
+		Long: "Move all relationships of the object, and all relationships with a subject set of the object, to a new object ID in one transaction.\n" +
+			"The new object must not have relationships yet.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			conn, err := client.GetWriteConn(cmd)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			resp, err := rts.NewWriteServiceClient(conn).RenameObject(cmd.Context(), &rts.RenameObjectRequest{
+				Namespace: namespace,
+				Object:    object,
+				NewObject: newObject,
+			})
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not make request: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Moved %d relationships.\n", resp.Renamed)
+			return nil
+		},
+	}
+
+	registerPackageFlags(cmd.Flags())
+	cmd.Flags().StringVar(&namespace, FlagNamespace, "", "Set the namespace of the object")
+	cmd.Flags().StringVar(&object, FlagObject, "", "Set the object to rename")
+	cmd.Flags().StringVar(&newObject, FlagNewObject, "", "Set the new ID of the object")
+	_ = cmd.MarkFlagRequired(FlagNamespace)
+	_ = cmd.MarkFlagRequired(FlagObject)
+	_ = cmd.MarkFlagRequired(FlagNewObject)
+
+	return cmd
+}
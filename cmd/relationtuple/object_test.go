@@ -0,0 +1,62 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ory/x/cmdx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/internal/namespace"
+)
+
+func TestObjectCmds(t *testing.T) {
+	ts := client.NewTestServer(t, client.WriteServer, []*namespace.Namespace{{Name: "n"}, {Name: "m"}}, NewDeleteObjectCmd)
+	defer ts.Shutdown(t)
+
+	importTuples := func(t *testing.T, tuples ...string) {
+		_, stderr, err := cmdx.ExecCtx(ts.Cmd.Ctx, NewImportCmd(), strings.NewReader(strings.Join(tuples, "\n")),
+			append(ts.Cmd.PersistentArgs, "--"+FlagTupleFormat, TupleFormatParse, "-")...)
+		require.NoError(t, err, stderr)
+	}
+	export := func(t *testing.T) (tuples []string) {
+		for _, n := range []string{"n", "m"} {
+			out := cmdx.ExecNoErrCtx(ts.Cmd.Ctx, t, NewExportCmd(), append(ts.Cmd.PersistentArgs, "--"+FlagNamespace, n, "--"+FlagTupleFormat, TupleFormatParse)...)
+			tuples = append(tuples, strings.Fields(out)...)
+		}
+		return tuples
+	}
+
+	importTuples(t, "n:doc#owner@alice", "n:doc#viewer@m:team#member", "m:folder#viewer@n:doc#owner", "n:other#owner@bob")
+
+	t.Run("case=rename object", func(t *testing.T) {
+		stdout := cmdx.ExecNoErrCtx(ts.Cmd.Ctx, t, NewRenameObjectCmd(), append(ts.Cmd.PersistentArgs,
+			"--"+FlagNamespace, "n", "--"+FlagObject, "doc", "--"+FlagNewObject, "report")...)
+		assert.Equal(t, "Moved 3 relationships.\n", stdout)
+		assert.ElementsMatch(t, []string{"n:report#owner@alice", "n:report#viewer@m:team#member", "m:folder#viewer@n:report#owner", "n:other#owner@bob"}, export(t))
+	})
+
+	t.Run("case=rename to existing object", func(t *testing.T) {
+		_, stderr, err := cmdx.ExecCtx(ts.Cmd.Ctx, NewRenameObjectCmd(), nil, append(ts.Cmd.PersistentArgs,
+			"--"+FlagNamespace, "n", "--"+FlagObject, "report", "--"+FlagNewObject, "other")...)
+		assert.ErrorIs(t, err, cmdx.ErrNoPrintButFail)
+		assert.Contains(t, stderr, "Could not make request")
+	})
+
+	t.Run("case=delete object requires force", func(t *testing.T) {
+		stdout := ts.Cmd.ExecNoErr(t, "--"+FlagNamespace, "n", "--"+FlagObject, "report")
+		assert.Contains(t, stdout, "WARNING: This operation is not reversible.")
+		assert.Len(t, export(t), 4)
+	})
+
+	t.Run("case=delete object", func(t *testing.T) {
+		stdout := ts.Cmd.ExecNoErr(t, "--"+FlagNamespace, "n", "--"+FlagObject, "report", "--"+FlagForce)
+		assert.Equal(t, "Deleted 3 relationships.\n", stdout)
+		assert.Equal(t, []string{"n:other#owner@bob"}, export(t))
+	})
+}
@@ -69,6 +69,10 @@ func parseFile(cmd *cobra.Command, fn string) ([]*ketoapi.RelationTuple, error)
 		return nil, cmdx.FailSilently(cmd)
 	}
 
+	return parseTuples(cmd, fn, fc)
+}
+
+func parseTuples(cmd *cobra.Command, fn string, fc []byte) ([]*ketoapi.RelationTuple, error) {
 	parts := strings.Split(string(fc), "\n")
 	rts := make([]*ketoapi.RelationTuple, 0, len(parts))
 	for i, row := range parts {
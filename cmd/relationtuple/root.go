@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/ory/keto/cmd/client"
+	"github.com/ory/keto/ketoctx"
 
 	"github.com/ory/x/cmdx"
 )
@@ -19,12 +20,12 @@ func newRelationCmd() *cobra.Command {
 	}
 }
 
-func RegisterCommandsRecursive(parent *cobra.Command) {
+func RegisterCommandsRecursive(parent *cobra.Command, opts []ketoctx.Option) {
 	relationCmd := newRelationCmd()
 
 	parent.AddCommand(relationCmd)
 
-	relationCmd.AddCommand(NewGetCmd(), NewCreateCmd(), NewDeleteCmd(), NewDeleteAllCmd(), NewParseCmd())
+	relationCmd.AddCommand(NewGetCmd(), NewCreateCmd(), NewDeleteCmd(), NewDeleteAllCmd(), NewDeleteObjectCmd(), NewRenameObjectCmd(), NewImportCmd(), NewExportCmd(), NewParseCmd(), NewValidateCmd(), NewLintCmd(opts))
 }
 
 func registerPackageFlags(flags *pflag.FlagSet) {
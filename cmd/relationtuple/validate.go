@@ -0,0 +1,187 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/keto/cmd/helpers"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/namespacelint"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/ketoapi"
+	"github.com/ory/keto/ketoctx"
+)
+
+const FlagSchema = "schema"
+
+type violationTable []*namespacelint.Violation
+
+func NewValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate --schema <namespaces.ts> <relationships>...",
+		Short: "Validate relationships against an OPL file",
+		Long: "Report every relationship whose namespace, relation, or subject type is not allowed by the OPL file.\n" +
+			"Reads JSON files as used by `create`, and human readable files as used by `parse`. " +
+			"Pass the special filename `-` to read from STD_IN.\n" +
+			"Subject IDs are not typed, so they are allowed in every relation.\n" +
+			"Fails if any relationship is not allowed.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespaces, err := parseOPLFile(cmd, flagx.MustGetString(cmd, FlagSchema))
+			if err != nil {
+				return err
+			}
+			v := namespacelint.NewValidator(namespaces)
+
+			var violations []*namespacelint.Violation
+			for _, fn := range args {
+				rts, err := readAnyTuples(cmd, fn)
+				if err != nil {
+					return err
+				}
+				for _, rt := range rts {
+					if reason := v.ValidateTuple(rt); reason != "" {
+						violations = append(violations, &namespacelint.Violation{RelationTuple: rt, Reason: reason})
+					}
+				}
+			}
+
+			return printViolations(cmd, violations)
+		},
+	}
+
+	cmd.Flags().String(FlagSchema, "", "The OPL file to validate against.")
+	_ = cmd.MarkFlagRequired(FlagSchema)
+	cmdx.RegisterFormatFlags(cmd.Flags())
+
+	return cmd
+}
+
+func NewLintCmd(opts []ketoctx.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Validate the stored relationships against the configured namespaces",
+		Long: "Page through all relationships in the configured database and report every one whose namespace, relation, " +
+			"or subject type is not allowed by the configured OPL namespaces.\n" +
+			"Subject IDs are not typed, so they are allowed in every relation.\n" +
+			"Fails if any relationship is not allowed.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reg, err := helpers.NewRegistry(cmd, opts)
+			if err != nil {
+				return err
+			}
+			nm, err := reg.Config(cmd.Context()).NamespaceManager()
+			if err != nil {
+				return err
+			}
+			namespaces, err := nm.Namespaces(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var violations []*namespacelint.Violation
+			scanned, err := namespacelint.LintStored(cmd.Context(), reg, namespacelint.NewValidator(namespaces), flagx.MustGetInt(cmd, FlagPageSize),
+				func(v *namespacelint.Violation) error {
+					violations = append(violations, v)
+					return nil
+				})
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not scan the stored relationships: %v\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Scanned %d relationships, %d are not allowed.\n", scanned, len(violations))
+
+			return printViolations(cmd, violations)
+		},
+	}
+
+	cmd.Flags().Int(FlagPageSize, 1000, "The number of relationships to load from the database at once.")
+	cmdx.RegisterFormatFlags(cmd.Flags())
+
+	return cmd
+}
+
+func printViolations(cmd *cobra.Command, violations []*namespacelint.Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	cmdx.PrintTable(cmd, violationTable(violations))
+	return cmdx.FailSilently(cmd)
+}
+
+// readAnyTuples reads relationships from a JSON file, or from a file with
+// human readable relationships.
+func readAnyTuples(cmd *cobra.Command, fn string) ([]*ketoapi.RelationTuple, error) {
+	var (
+		fc  []byte
+		err error
+	)
+	if fn == "-" {
+		fn = "stdin"
+		fc, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		fc, err = os.ReadFile(fn)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read file %s: %v\n", fn, err)
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	if trimmed := bytes.TrimSpace(fc); len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		return decodeTuples(cmd, trimmed)
+	}
+	return parseTuples(cmd, fn, fc)
+}
+
+func parseOPLFile(cmd *cobra.Command, fn string) ([]*namespace.Namespace, error) {
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read file %s: %v\n", fn, err)
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	nn, errs := schema.Parse(string(content))
+	if len(errs) > 0 {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not parse %s:\n", fn)
+		for _, e := range errs {
+			_, _ = fmt.Fprintln(cmd.ErrOrStderr(), e.Error())
+		}
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+	return namespaces, nil
+}
+
+func (t violationTable) Header() []string {
+	return []string{"RELATIONSHIP", "REASON"}
+}
+
+func (t violationTable) Table() [][]string {
+	rows := make([][]string, len(t))
+	for i, v := range t {
+		rows[i] = []string{v.RelationTuple.String(), v.Reason}
+	}
+	return rows
+}
+
+func (t violationTable) Interface() interface{} {
+	return []*namespacelint.Violation(t)
+}
+
+func (t violationTable) Len() int {
+	return len(t)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ory/x/cmdx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCmd(t *testing.T) {
+	cmd := cmdx.CommandExecuter{New: NewValidateCmd}
+
+	dir := t.TempDir()
+	schemaFn, textFn, jsonFn := filepath.Join(dir, "namespaces.ts"), filepath.Join(dir, "tuples.txt"), filepath.Join(dir, "tuples.json")
+	require.NoError(t, os.WriteFile(schemaFn, []byte(`
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    viewers: User[]
+  }
+}
+`), 0600))
+	require.NoError(t, os.WriteFile(textFn, []byte(`// valid
+Document:d#viewers@User:u
+Document:d#viewers@u
+// invalid
+Document:d#editors@User:u
+`), 0600))
+	require.NoError(t, os.WriteFile(jsonFn, []byte(`[
+  {"namespace": "Folder", "object": "f", "relation": "viewers", "subject_id": "u"}
+]`), 0600))
+
+	t.Run("case=reports violations of both formats", func(t *testing.T) {
+		stdOut, _, err := cmd.Exec(nil, "--"+FlagSchema, schemaFn, "--"+cmdx.FlagFormat, string(cmdx.FormatJSON), textFn, jsonFn)
+		require.ErrorIs(t, err, cmdx.ErrNoPrintButFail)
+
+		var violations []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(stdOut), &violations), stdOut)
+		require.Len(t, violations, 2)
+		assert.Equal(t, `relation "editors" is not defined in namespace "Document"`, violations[0]["reason"])
+		assert.Equal(t, `namespace "Folder" is not defined`, violations[1]["reason"])
+	})
+
+	t.Run("case=succeeds without violations", func(t *testing.T) {
+		validFn := filepath.Join(dir, "valid.txt")
+		require.NoError(t, os.WriteFile(validFn, []byte("Document:d#viewers@User:u\n"), 0600))
+		cmd.ExecNoErr(t, "--"+FlagSchema, schemaFn, validFn)
+	})
+}
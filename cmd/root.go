@@ -25,6 +25,8 @@ import (
 	"github.com/ory/x/cmdx"
 	"github.com/ory/x/configx"
 
+	"github.com/ory/keto/cmd/audit"
+	"github.com/ory/keto/cmd/cleanup"
 	"github.com/ory/keto/cmd/migrate"
 	"github.com/ory/keto/cmd/namespace"
 	"github.com/ory/keto/cmd/relationtuple"
@@ -41,13 +43,15 @@ func NewRootCmd(opts ...ketoctx.Option) *cobra.Command {
 
 	configx.RegisterConfigFlag(cmd.PersistentFlags(), []string{filepath.Join(userHomeDir(), "keto.yml")})
 
-	relationtuple.RegisterCommandsRecursive(cmd)
+	relationtuple.RegisterCommandsRecursive(cmd, opts)
 	namespace.RegisterCommandsRecursive(cmd, opts)
 	migrate.RegisterCommandsRecursive(cmd, opts)
+	cleanup.RegisterCommandsRecursive(cmd, opts)
 	server.RegisterCommandsRecursive(cmd, opts)
 	check.RegisterCommandsRecursive(cmd)
 	expand.RegisterCommandsRecursive(cmd)
 	status.RegisterCommandRecursive(cmd)
+	audit.RegisterCommandsRecursive(cmd)
 
 	cmd.AddCommand(cmdx.Version(&config.Version, &config.Commit, &config.Date))
 
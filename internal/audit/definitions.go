@@ -0,0 +1,56 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+)
+
+type (
+	// Entry is one write of relationships, with the changes it committed.
+	Entry struct {
+		Time        time.Time
+		Operation   string
+		Actor       string
+		ActorSource string
+		RequestID   string
+		Changes     []*relationtuple.Change
+	}
+	// Filter selects the entries to list. Empty fields match all entries.
+	Filter struct {
+		Actor     string
+		RequestID string
+	}
+	Manager interface {
+		// ListAuditEntries lists the entries matching the filter, the
+		// latest first.
+		ListAuditEntries(ctx context.Context, filter *Filter, opts ...x.PaginationOptionSetter) ([]*Entry, string, error)
+	}
+	ManagerProvider interface {
+		AuditManager() Manager
+	}
+)
+
+// The operations of the relationship manager that are recorded.
+const (
+	OperationWrite     = "write"
+	OperationDelete    = "delete"
+	OperationDeleteAll = "delete_all"
+	OperationTransact  = "transact"
+	OperationImport    = "import"
+
+	OperationDeleteObject = "delete_object"
+	OperationRenameObject = "rename_object"
+)
+
+// The sources of the actor of a write.
+const (
+	ActorSourceHeader   = "header"
+	ActorSourceMetadata = "metadata"
+	ActorSourceMTLS     = "mtls"
+)
@@ -0,0 +1,160 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+type (
+	handlerDeps interface {
+		ManagerProvider
+		relationtuple.MapperProvider
+		x.WriterProvider
+	}
+	handler struct {
+		d handlerDeps
+	}
+)
+
+const RouteBase = "/admin/audit-log"
+
+var _ rts.AuditServiceServer = (*handler)(nil)
+
+func NewHandler(d handlerDeps) *handler {
+	return &handler{d: d}
+}
+
+func (h *handler) RegisterWriteRoutes(r *x.WriteRouter) {
+	r.GET(RouteBase, h.getAuditEntries)
+}
+
+func (h *handler) RegisterWriteGRPC(s *grpc.Server) {
+	rts.RegisterAuditServiceServer(s, h)
+}
+
+func (h *handler) ListAuditEntries(ctx context.Context, req *rts.ListAuditEntriesRequest) (*rts.ListAuditEntriesResponse, error) {
+	res, err := h.listAuditEntries(ctx, &Filter{Actor: req.Actor, RequestID: req.RequestId},
+		x.WithSize(int(req.PageSize)),
+		x.WithToken(req.PageToken),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRes := &rts.ListAuditEntriesResponse{
+		Entries:       make([]*rts.AuditEntry, len(res.Entries)),
+		NextPageToken: res.NextPageToken,
+	}
+	for i, e := range res.Entries {
+		protoRes.Entries[i] = e.ToProto()
+	}
+	return protoRes, nil
+}
+
+func (h *handler) listAuditEntries(ctx context.Context, filter *Filter, opts ...x.PaginationOptionSetter) (*ketoapi.ListAuditEntriesResponse, error) {
+	entries, nextPage, err := h.d.AuditManager().ListAuditEntries(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ketoapi.ListAuditEntriesResponse{
+		Entries:       make([]*ketoapi.AuditEntry, len(entries)),
+		NextPageToken: nextPage,
+	}
+	for i, e := range entries {
+		tuples := make([]*relationtuple.RelationTuple, len(e.Changes))
+		for j, c := range e.Changes {
+			tuples[j] = c.RelationTuple
+		}
+		mapped, err := h.d.Mapper().ToTuple(ctx, tuples...)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Entries[i] = &ketoapi.AuditEntry{
+			Time:        e.Time,
+			Operation:   e.Operation,
+			Actor:       e.Actor,
+			ActorSource: e.ActorSource,
+			RequestID:   e.RequestID,
+			Deltas:      make([]*ketoapi.PatchDelta, len(mapped)),
+		}
+		for j, t := range mapped {
+			res.Entries[i].Deltas[j] = &ketoapi.PatchDelta{Action: e.Changes[j].Action, RelationTuple: t}
+		}
+	}
+	return res, nil
+}
+
+// List Audit Entries Request Parameters
+//
+// swagger:parameters listAuditEntries
+// nolint:deadcode,unused
+type listAuditEntries struct {
+	// Only list the writes of this actor.
+	//
+	// in: query
+	Actor string `json:"actor"`
+
+	// Only list the writes of this request.
+	//
+	// in: query
+	RequestID string `json:"request_id"`
+
+	// swagger:allOf
+	x.PaginationOptions
+}
+
+// swagger:route GET /admin/audit-log relationship listAuditEntries
+//
+// # List the audit log of relationship writes
+//
+// Lists the recorded writes, the latest first. Writes are only recorded if the
+// audit log is enabled.
+//
+//	Consumes:
+//	-  application/x-www-form-urlencoded
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: auditEntries
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *handler) getAuditEntries(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	q := r.URL.Query()
+
+	opts := []x.PaginationOptionSetter{x.WithToken(q.Get("page_token"))}
+	if pageSize := q.Get("page_size"); pageSize != "" {
+		s, err := strconv.ParseInt(pageSize, 0, 0)
+		if err != nil {
+			h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+			return
+		}
+		opts = append(opts, x.WithSize(int(s)))
+	}
+
+	res, err := h.listAuditEntries(r.Context(), &Filter{Actor: q.Get("actor"), RequestID: q.Get("request_id")}, opts...)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	h.d.Writer().Write(w, r, res)
+}
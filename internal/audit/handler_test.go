@@ -0,0 +1,125 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "n"}}))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyAuditEnabled, true))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyAuditActorHeader, "X-Actor"))
+
+	ts := httptest.NewServer(reg.WriteRouter(ctx))
+	t.Cleanup(ts.Close)
+
+	l := bufconn.Listen(1024 * 1024)
+	s := reg.WriteGRPCServer(ctx)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			t.Logf("Server exited with error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	tuple := &ketoapi.RelationTuple{Namespace: "n", Object: "o", Relation: "r", SubjectID: pointerx.Ptr("s")}
+
+	t.Run("case=REST writes are attributed to the actor header", func(t *testing.T) {
+		body, err := json.Marshal(tuple)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPut, ts.URL+relationtuple.WriteRouteBase, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("X-Actor", "alice")
+		req.Header.Set(audit.RequestIDHeader, "rest-request")
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, err = ts.Client().Get(ts.URL + audit.RouteBase + "?actor=alice")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var res ketoapi.ListAuditEntriesResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		require.Len(t, res.Entries, 1)
+		e := res.Entries[0]
+		assert.Equal(t, audit.OperationWrite, e.Operation)
+		assert.Equal(t, audit.ActorSourceHeader, e.ActorSource)
+		assert.Equal(t, "rest-request", e.RequestID)
+		require.Len(t, e.Deltas, 1)
+		assert.Equal(t, ketoapi.ActionInsert, e.Deltas[0].Action)
+		assert.Equal(t, tuple, e.Deltas[0].RelationTuple)
+	})
+
+	t.Run("case=gRPC writes are attributed to the actor metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(ctx, "X-Actor", "bob")
+		_, err := rts.NewWriteServiceClient(conn).DeleteRelationTuples(ctx, &rts.DeleteRelationTuplesRequest{
+			RelationQuery: (&ketoapi.RelationQuery{Namespace: &tuple.Namespace}).ToProto(),
+		})
+		require.NoError(t, err)
+
+		res, err := rts.NewAuditServiceClient(conn).ListAuditEntries(ctx, &rts.ListAuditEntriesRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 2)
+
+		e, err := (&ketoapi.AuditEntry{}).FromProto(res.Entries[0])
+		require.NoError(t, err)
+		assert.Equal(t, audit.OperationDeleteAll, e.Operation)
+		assert.Equal(t, "bob", e.Actor)
+		assert.Equal(t, audit.ActorSourceMetadata, e.ActorSource)
+		require.Len(t, e.Deltas, 1)
+		assert.Equal(t, ketoapi.ActionDelete, e.Deltas[0].Action)
+		assert.Equal(t, tuple, e.Deltas[0].RelationTuple)
+	})
+
+	t.Run("case=unattributed writes have no actor", func(t *testing.T) {
+		_, err := rts.NewWriteServiceClient(conn).TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+			RelationTupleDeltas: []*rts.RelationTupleDelta{{
+				Action:        rts.RelationTupleDelta_ACTION_INSERT,
+				RelationTuple: tuple.ToProto(),
+			}},
+		})
+		require.NoError(t, err)
+
+		res, err := rts.NewAuditServiceClient(conn).ListAuditEntries(ctx, &rts.ListAuditEntriesRequest{PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		assert.NotEmpty(t, res.NextPageToken)
+		assert.Equal(t, audit.OperationTransact, res.Entries[0].Operation)
+		assert.Empty(t, res.Entries[0].Actor)
+		assert.NotEmpty(t, res.Entries[0].RequestId)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/ory/keto/internal/driver/config"
+)
+
+type (
+	// Request describes who sent a write.
+	Request struct {
+		Actor       string
+		ActorSource string
+		RequestID   string
+	}
+	requestKey struct{}
+)
+
+const RequestIDHeader = "X-Request-Id"
+
+// WithRequest returns a context that attributes the writes in it to the
+// request.
+func WithRequest(ctx context.Context, r *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, r)
+}
+
+// RequestFromContext returns the request of the writes in the context, or an
+// empty request.
+func RequestFromContext(ctx context.Context) *Request {
+	if r, ok := ctx.Value(requestKey{}).(*Request); ok {
+		return r
+	}
+	return &Request{}
+}
+
+// HTTPMiddleware attributes the writes of HTTP requests to the actor in the
+// configured header, or to the identity of the verified client certificate.
+func HTTPMiddleware(d config.Provider) func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	return func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		ctx := r.Context()
+		req := &Request{RequestID: r.Header.Get(RequestIDHeader)}
+		if h := d.Config(ctx).AuditActorHeader(); h != "" && r.Header.Get(h) != "" {
+			req.Actor, req.ActorSource = r.Header.Get(h), ActorSourceHeader
+		} else if id := tlsIdentity(r.TLS); id != "" {
+			req.Actor, req.ActorSource = id, ActorSourceMTLS
+		}
+		next(rw, r.WithContext(WithRequest(ctx, req)))
+	}
+}
+
+// UnaryServerInterceptor attributes the writes of gRPC calls to the actor in
+// the configured metadata key, or to the identity of the verified client
+// certificate.
+func UnaryServerInterceptor(d config.Provider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withGRPCRequest(ctx, d), req)
+	}
+}
+
+// StreamServerInterceptor is the streaming variant of UnaryServerInterceptor.
+func StreamServerInterceptor(d config.Provider) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withGRPCRequest(ss.Context(), d)
+		return handler(srv, wrapped)
+	}
+}
+
+func withGRPCRequest(ctx context.Context, d config.Provider) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	req := &Request{RequestID: first(RequestIDHeader)}
+	if h := d.Config(ctx).AuditActorHeader(); h != "" && first(h) != "" {
+		req.Actor, req.ActorSource = first(h), ActorSourceMetadata
+	} else if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if id := tlsIdentity(&info.State); id != "" {
+				req.Actor, req.ActorSource = id, ActorSourceMTLS
+			}
+		}
+	}
+	return WithRequest(ctx, req)
+}
+
+// tlsIdentity returns the identity of the verified client certificate: its
+// first URI, e.g. a SPIFFE ID, or its common name.
+func tlsIdentity(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return certIdentity(state.VerifiedChains[0][0])
+}
+
+func certIdentity(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return strings.TrimSpace(cert.Subject.CommonName)
+}
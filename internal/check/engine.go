@@ -28,7 +28,8 @@ type (
 		PermissionEngine() *Engine
 	}
 	Engine struct {
-		d EngineDependencies
+		d  EngineDependencies
+		nm namespace.Manager
 	}
 	EngineDependencies interface {
 		relationtuple.ManagerProvider
@@ -45,6 +46,14 @@ type (
 
 const WildcardRelation = "..."
 
+// WithNamespaceManager makes the engine evaluate the checks against the given
+// namespaces instead of the configured ones.
+func WithNamespaceManager(nm namespace.Manager) EngineOpt {
+	return func(e *Engine) {
+		e.nm = nm
+	}
+}
+
 func NewEngine(d EngineDependencies, opts ...EngineOpt) *Engine {
 	e := &Engine{d: d}
 	for _, opt := range opts {
@@ -208,11 +217,18 @@ func (e *Engine) checkIsAllowed(ctx context.Context, r *relationTuple, restDepth
 		WithField("request", r.String()).
 		Trace("check is allowed")
 
+	relation, err := e.astRelationFor(ctx, r)
+	if err == nil && relation != nil && !subjectTypeAllowed(relation, r.Subject) {
+		e.d.Logger().
+			WithField("request", r.String()).
+			Trace("subject type is not allowed")
+		return checkgroup.NotMemberFunc
+	}
+
 	g := checkgroup.New(ctx)
 	g.Add(e.checkDirect(r, restDepth-1))
 	g.Add(e.checkExpandSubject(r, restDepth))
 
-	relation, err := e.astRelationFor(ctx, r)
 	if err != nil {
 		g.Add(checkgroup.ErrorFunc(err))
 	} else if relation != nil && relation.SubjectSetRewrite != nil {
@@ -222,6 +238,25 @@ func (e *Engine) checkIsAllowed(ctx context.Context, r *relationTuple, restDepth
 	return g.CheckFunc()
 }
 
+// subjectTypeAllowed returns whether the subject has one of the subject types
+// that the relation applies to. Subject IDs are not typed, so they are always
+// allowed.
+func subjectTypeAllowed(relation *ast.Relation, subject relationtuple.Subject) bool {
+	if len(relation.SubjectTypes) == 0 {
+		return true
+	}
+	s, ok := subject.(*relationtuple.SubjectSet)
+	if !ok {
+		return true
+	}
+	for _, t := range relation.SubjectTypes {
+		if t.Namespace == s.Namespace && t.Relation == s.Relation {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Engine) astRelationFor(ctx context.Context, r *relationTuple) (*ast.Relation, error) {
 	// Special case: If the relationTuple's relation is empty, then it is not an
 	// error that the relation was not found.
@@ -251,9 +286,13 @@ func (e *Engine) astRelationFor(ctx context.Context, r *relationTuple) (*ast.Rel
 }
 
 func (e *Engine) namespaceFor(ctx context.Context, r *relationTuple) (*namespace.Namespace, error) {
-	namespaceManager, err := e.d.Config(ctx).NamespaceManager()
-	if err != nil {
-		return nil, err
+	namespaceManager := e.nm
+	if namespaceManager == nil {
+		var err error
+		namespaceManager, err = e.d.Config(ctx).NamespaceManager()
+		if err != nil {
+			return nil, err
+		}
 	}
 	ns, err := namespaceManager.GetNamespaceByName(ctx, r.Namespace)
 	if err != nil {
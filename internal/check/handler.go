@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"google.golang.org/grpc"
 
+	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
@@ -29,11 +31,14 @@ type (
 		EngineProvider
 		relationtuple.ManagerProvider
 		relationtuple.MapperProvider
+		relationtuple.SnaptokenManagerProvider
 		x.LoggerProvider
 		x.WriterProvider
+		config.Provider
 	}
 	Handler struct {
-		d handlerDependencies
+		d           handlerDependencies
+		shadowSlots chan struct{}
 	}
 )
 
@@ -43,7 +48,7 @@ var (
 )
 
 func NewHandler(d handlerDependencies) *Handler {
-	return &Handler{d: d}
+	return &Handler{d: d, shadowSlots: make(chan struct{}, maxConcurrentShadowChecks)}
 }
 
 const (
@@ -56,6 +61,7 @@ func (h *Handler) RegisterReadRoutes(r *x.ReadRouter) {
 	r.GET(OpenAPIRouteBase, h.getCheckNoStatus)
 	r.POST(RouteBase, h.postCheckMirrorStatus)
 	r.POST(OpenAPIRouteBase, h.postCheckNoStatus)
+	r.POST(SimulateRouteBase, h.postSimulate)
 }
 
 func (h *Handler) RegisterReadGRPC(s *grpc.Server) {
@@ -72,6 +78,9 @@ type CheckPermissionResult struct {
 	//
 	// required: true
 	Allowed bool `json:"allowed"`
+
+	// the hash of the OPL schema that was used for the check
+	SchemaHash string `json:"schema_hash,omitempty"`
 }
 
 // Check Permission Request Parameters
@@ -80,6 +89,19 @@ type CheckPermissionResult struct {
 type checkPermission struct {
 	// in: query
 	MaxDepth int `json:"max-depth"`
+
+	// Evaluates the check on the relationships as they were at this time, in
+	// RFC 3339 format. Requires the history mode, and a time within its
+	// retention.
+	//
+	// in: query
+	AsOf *time.Time `json:"as_of"`
+
+	// Evaluates the check on relationships no older than this snaptoken,
+	// reading from the primary database if the read replica lags behind.
+	//
+	// in: query
+	Snaptoken string `json:"snaptoken"`
 }
 
 // swagger:route GET /relation-tuples/check/openapi permission checkPermission
@@ -106,7 +128,7 @@ func (h *Handler) getCheckNoStatus(w http.ResponseWriter, r *http.Request, _ htt
 		h.d.Writer().WriteError(w, r, err)
 		return
 	}
-	h.d.Writer().Write(w, r, &CheckPermissionResult{Allowed: allowed})
+	h.d.Writer().Write(w, r, h.result(r.Context(), allowed))
 }
 
 // Check Permission Or Error Request Parameters
@@ -115,6 +137,19 @@ func (h *Handler) getCheckNoStatus(w http.ResponseWriter, r *http.Request, _ htt
 type checkPermissionOrError struct {
 	// in: query
 	MaxDepth int `json:"max-depth"`
+
+	// Evaluates the check on the relationships as they were at this time, in
+	// RFC 3339 format. Requires the history mode, and a time within its
+	// retention.
+	//
+	// in: query
+	AsOf *time.Time `json:"as_of"`
+
+	// Evaluates the check on relationships no older than this snaptoken,
+	// reading from the primary database if the read replica lags behind.
+	//
+	// in: query
+	Snaptoken string `json:"snaptoken"`
 }
 
 // swagger:route GET /relation-tuples/check permission checkPermissionOrError
@@ -144,11 +179,11 @@ func (h *Handler) getCheckMirrorStatus(w http.ResponseWriter, r *http.Request, _
 	}
 
 	if allowed {
-		h.d.Writer().Write(w, r, &CheckPermissionResult{Allowed: allowed})
+		h.d.Writer().Write(w, r, h.result(r.Context(), allowed))
 		return
 	}
 
-	h.d.Writer().WriteCode(w, r, http.StatusForbidden, &CheckPermissionResult{Allowed: allowed})
+	h.d.Writer().WriteCode(w, r, http.StatusForbidden, h.result(r.Context(), allowed))
 }
 
 func (h *Handler) getCheck(ctx context.Context, q url.Values) (bool, error) {
@@ -156,6 +191,14 @@ func (h *Handler) getCheck(ctx context.Context, q url.Values) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	ctx, err = h.withAsOfFromQuery(ctx, q)
+	if err != nil {
+		return false, err
+	}
+	ctx, err = relationtuple.WithSnaptoken(ctx, h.d, q.Get("snaptoken"))
+	if err != nil {
+		return false, err
+	}
 
 	tuple, err := (&ketoapi.RelationTuple{}).FromURLQuery(q)
 	if err != nil {
@@ -170,7 +213,7 @@ func (h *Handler) getCheck(ctx context.Context, q url.Values) (bool, error) {
 		return false, err
 	}
 
-	return h.d.PermissionEngine().CheckIsMember(ctx, it[0], maxDepth)
+	return h.checkIsMember(ctx, it[0], maxDepth)
 }
 
 // Check Permission using Post Request Parameters
@@ -180,6 +223,19 @@ type postCheckPermission struct {
 	// in: query
 	MaxDepth int `json:"max-depth"`
 
+	// Evaluates the check on the relationships as they were at this time, in
+	// RFC 3339 format. Requires the history mode, and a time within its
+	// retention.
+	//
+	// in: query
+	AsOf *time.Time `json:"as_of"`
+
+	// Evaluates the check on relationships no older than this snaptoken,
+	// reading from the primary database if the read replica lags behind.
+	//
+	// in: query
+	Snaptoken string `json:"snaptoken"`
+
 	// in: body
 	Payload postCheckPermissionBody
 }
@@ -215,7 +271,7 @@ func (h *Handler) postCheckNoStatus(w http.ResponseWriter, r *http.Request, _ ht
 		h.d.Writer().WriteError(w, r, err)
 		return
 	}
-	h.d.Writer().Write(w, r, &CheckPermissionResult{Allowed: allowed})
+	h.d.Writer().Write(w, r, h.result(r.Context(), allowed))
 }
 
 // Post Check Permission Or Error Request Parameters
@@ -225,6 +281,19 @@ type postCheckPermissionOrError struct {
 	// in: query
 	MaxDepth int `json:"max-depth"`
 
+	// Evaluates the check on the relationships as they were at this time, in
+	// RFC 3339 format. Requires the history mode, and a time within its
+	// retention.
+	//
+	// in: query
+	AsOf *time.Time `json:"as_of"`
+
+	// Evaluates the check on relationships no older than this snaptoken,
+	// reading from the primary database if the read replica lags behind.
+	//
+	// in: query
+	Snaptoken string `json:"snaptoken"`
+
 	// in: body
 	Body postCheckPermissionOrErrorBody
 }
@@ -263,11 +332,11 @@ func (h *Handler) postCheckMirrorStatus(w http.ResponseWriter, r *http.Request,
 	}
 
 	if allowed {
-		h.d.Writer().Write(w, r, &CheckPermissionResult{Allowed: allowed})
+		h.d.Writer().Write(w, r, h.result(r.Context(), allowed))
 		return
 	}
 
-	h.d.Writer().WriteCode(w, r, http.StatusForbidden, &CheckPermissionResult{Allowed: allowed})
+	h.d.Writer().WriteCode(w, r, http.StatusForbidden, h.result(r.Context(), allowed))
 }
 
 func (h *Handler) postCheck(ctx context.Context, body io.Reader, query url.Values) (bool, error) {
@@ -275,6 +344,14 @@ func (h *Handler) postCheck(ctx context.Context, body io.Reader, query url.Value
 	if err != nil {
 		return false, err
 	}
+	ctx, err = h.withAsOfFromQuery(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	ctx, err = relationtuple.WithSnaptoken(ctx, h.d, query.Get("snaptoken"))
+	if err != nil {
+		return false, err
+	}
 
 	var tuple ketoapi.RelationTuple
 	if err := json.NewDecoder(body).Decode(&tuple); err != nil {
@@ -288,7 +365,7 @@ func (h *Handler) postCheck(ctx context.Context, body io.Reader, query url.Value
 		return false, err
 	}
 
-	return h.d.PermissionEngine().CheckIsMember(ctx, t[0], maxDepth)
+	return h.checkIsMember(ctx, t[0], maxDepth)
 }
 
 func (h *Handler) Check(ctx context.Context, req *rts.CheckRequest) (*rts.CheckResponse, error) {
@@ -304,18 +381,55 @@ func (h *Handler) Check(ctx context.Context, req *rts.CheckRequest) (*rts.CheckR
 		return nil, err
 	}
 
+	asOf, err := relationtuple.AsOfFromProto(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = relationtuple.WithAsOf(ctx, h.d, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	// A content-change check reads the latest relationships from the primary
+	// database and returns the snaptoken of the writes it observed.
+	var snaptoken string
+	if req.Latest {
+		ctx = relationtuple.WithPrimary(ctx)
+		snaptoken, err = h.d.SnaptokenManager().Snaptoken(ctx)
+	} else {
+		ctx, err = relationtuple.WithSnaptoken(ctx, h.d, req.Snaptoken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	internalTuple, err := h.d.Mapper().FromTuple(ctx, tuple)
 	if err != nil {
 		return nil, err
 	}
-	allowed, err := h.d.PermissionEngine().CheckIsMember(ctx, internalTuple[0], int(req.MaxDepth))
-	// TODO add content change handling
+	allowed, err := h.checkIsMember(ctx, internalTuple[0], int(req.MaxDepth))
 	if err != nil {
 		return nil, err
 	}
 
 	return &rts.CheckResponse{
-		Allowed:   allowed,
-		Snaptoken: "not yet implemented",
+		Allowed:    allowed,
+		Snaptoken:  snaptoken,
+		SchemaHash: h.d.Config(ctx).SchemaStatus().Hash,
 	}, nil
 }
+
+func (h *Handler) withAsOfFromQuery(ctx context.Context, q url.Values) (context.Context, error) {
+	asOf, err := x.GetAsOfFromQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	return relationtuple.WithAsOf(ctx, h.d, asOf)
+}
+
+func (h *Handler) result(ctx context.Context, allowed bool) *CheckPermissionResult {
+	return &CheckPermissionResult{
+		Allowed:    allowed,
+		SchemaHash: h.d.Config(ctx).SchemaStatus().Hash,
+	}
+}
@@ -5,11 +5,16 @@ package check_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ory/x/pointerx"
 
@@ -18,15 +23,18 @@ import (
 	"github.com/ory/keto/internal/driver/config"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ory/keto/internal/check"
 	"github.com/ory/keto/internal/driver"
 	"github.com/ory/keto/internal/namespace"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
 
 func assertAllowed(t *testing.T, resp *http.Response) {
@@ -156,3 +164,123 @@ func TestRESTHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaHash(t *testing.T) {
+	ctx := context.Background()
+
+	opl := "class User implements Namespace {}"
+	fn := filepath.Join(t.TempDir(), "namespaces.ts")
+	require.NoError(t, os.WriteFile(fn, []byte(opl), 0600))
+
+	reg := driver.NewSqliteTestRegistry(t, false)
+	require.NoError(t, reg.Config(ctx).Set(config.KeyNamespaces, map[string]any{"location": "file://" + fn}))
+	h := check.NewHandler(reg)
+	r := httprouter.New()
+	h.RegisterReadRoutes(&x.ReadRouter{Router: r})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	hash := sha256.Sum256([]byte(opl))
+
+	resp, err := ts.Client().Get(ts.URL + check.OpenAPIRouteBase + "?" + (&ketoapi.RelationTuple{
+		Namespace: "User",
+		Object:    "o",
+		Relation:  "r",
+		SubjectID: pointerx.Ptr("s"),
+	}).ToURLQuery().Encode())
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(hash[:]), gjson.GetBytes(body, "schema_hash").String(), "%s", body)
+
+	res, err := h.Check(ctx, &rts.CheckRequest{Tuple: &rts.RelationTuple{
+		Namespace: "User",
+		Object:    "o",
+		Relation:  "r",
+		Subject:   rts.NewSubjectID("s"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(hash[:]), res.SchemaHash)
+}
+
+func TestCheckAsOf(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "Document"}}))
+	h := check.NewHandler(reg)
+	r := httprouter.New()
+	h.RegisterReadRoutes(&x.ReadRouter{Router: r})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	rt := &ketoapi.RelationTuple{
+		Namespace: "Document",
+		Object:    "d",
+		Relation:  "viewers",
+		SubjectID: pointerx.Ptr("bob"),
+	}
+	checkAsOf := func(t *testing.T, asOf time.Time) *http.Response {
+		q := rt.ToURLQuery()
+		q.Set("as_of", asOf.Format(time.RFC3339Nano))
+		resp, err := ts.Client().Get(ts.URL + check.RouteBase + "?" + q.Encode())
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("case=requires the history mode", func(t *testing.T) {
+		resp := checkAsOf(t, time.Now())
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, string(body), "history mode")
+	})
+
+	require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, true))
+	relationtuple.MapAndWriteTuples(t, reg, rt)
+	time.Sleep(10 * time.Millisecond)
+	whileViewer := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	its, err := reg.Mapper().FromTuple(ctx, rt)
+	require.NoError(t, err)
+	require.NoError(t, reg.RelationTupleManager().DeleteRelationTuples(ctx, its...))
+
+	t.Run("case=REST", func(t *testing.T) {
+		assertAllowed(t, checkAsOf(t, whileViewer))
+		baseAssertDenied(t, checkAsOf(t, time.Now()))
+
+		resp, err := ts.Client().Get(ts.URL + check.RouteBase + "?" + rt.ToURLQuery().Encode() + "&as_of=last-tuesday")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("case=gRPC", func(t *testing.T) {
+		for _, tc := range []struct {
+			asOf    time.Time
+			allowed bool
+		}{
+			{asOf: whileViewer, allowed: true},
+			{asOf: time.Now(), allowed: false},
+		} {
+			ctx, cancel := context.WithCancel(ctx)
+			res, err := h.Check(ctx, &rts.CheckRequest{Tuple: rt.ToProto(), AsOf: timestamppb.New(tc.asOf)})
+			cancel()
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, res.Allowed)
+		}
+	})
+
+	t.Run("case=reads before the retention fail", func(t *testing.T) {
+		require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryRetention, "1h"))
+		t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryRetention, "720h")) })
+
+		resp := checkAsOf(t, time.Now().Add(-2*time.Hour))
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, string(body), "history.retention")
+
+		_, err = h.Check(ctx, &rts.CheckRequest{Tuple: rt.ToProto(), AsOf: timestamppb.New(time.Now().Add(-2 * time.Hour))})
+		assert.ErrorIs(t, err, herodot.ErrBadRequest)
+
+		assertAllowed(t, checkAsOf(t, whileViewer))
+	})
+}
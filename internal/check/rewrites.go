@@ -67,6 +67,12 @@ func (e *Engine) checkSubjectSetRewrite(
 				Type:  ketoapi.TreeNodeTupleToSubjectSet,
 			}, e.checkTupleToSubjectSet(tuple, c, restDepth)))
 
+		case *ast.SubjectSetTraversal:
+			checks = append(checks, checkgroup.WithEdge(checkgroup.Edge{
+				Tuple: *tuple,
+				Type:  ketoapi.TreeNodeTupleToSubjectSet,
+			}, e.checkTraversal(tuple, c, restDepth)))
+
 		case *ast.ComputedSubjectSet:
 			checks = append(checks, checkgroup.WithEdge(checkgroup.Edge{
 				Tuple: *tuple,
@@ -120,6 +126,12 @@ func (e *Engine) checkInverted(
 			Type:  ketoapi.TreeNodeTupleToSubjectSet,
 		}, e.checkTupleToSubjectSet(tuple, c, restDepth))
 
+	case *ast.SubjectSetTraversal:
+		check = checkgroup.WithEdge(checkgroup.Edge{
+			Tuple: *tuple,
+			Type:  ketoapi.TreeNodeTupleToSubjectSet,
+		}, e.checkTraversal(tuple, c, restDepth))
+
 	case *ast.ComputedSubjectSet:
 		check = checkgroup.WithEdge(checkgroup.Edge{
 			Tuple: *tuple,
@@ -221,6 +233,69 @@ func (e *Engine) checkTupleToSubjectSet(
 		WithField("tuple to subject-set computed", subjectSet.ComputedSubjectSetRelation).
 		Trace("check tuple to subjectSet")
 
+	return e.forEachTraversedSubjectSet(tuple, subjectSet.Relation, func(ctx context.Context, subSet *relationtuple.SubjectSet) checkgroup.CheckFunc {
+		return e.checkIsAllowed(
+			ctx,
+			&relationTuple{
+				Namespace: subSet.Namespace,
+				Object:    subSet.Object,
+				Relation:  subjectSet.ComputedSubjectSetRelation,
+				Subject:   tuple.Subject,
+			},
+			restDepth-1,
+		)
+	})
+}
+
+// checkTraversal evaluates the rewrite of the traversal on the traversed subject
+// sets.
+//
+// Given a relation tuple like docs:readme#view@user, and a traversal of the
+// relation "parent", the following checks will be performed:
+//
+//   - query for all tuples like docs:readme#parent@??? to get a list of subjects
+//     that have the parent relation on docs:readme
+//
+// * For each matching subject, evaluate the rewrite on the subject's namespace
+// and object, for the same user.
+func (e *Engine) checkTraversal(
+	tuple *relationTuple,
+	traversal *ast.SubjectSetTraversal,
+	restDepth int,
+) checkgroup.CheckFunc {
+	if restDepth < 0 {
+		e.d.Logger().Debug("reached max-depth, therefore this query will not be further expanded")
+		return checkgroup.UnknownMemberFunc
+	}
+
+	e.d.Logger().
+		WithField("request", tuple.String()).
+		WithField("traversal relation", traversal.Relation).
+		Trace("check traversal")
+
+	return e.forEachTraversedSubjectSet(tuple, traversal.Relation, func(ctx context.Context, subSet *relationtuple.SubjectSet) checkgroup.CheckFunc {
+		return e.checkSubjectSetRewrite(
+			ctx,
+			&relationTuple{
+				Namespace: subSet.Namespace,
+				Object:    subSet.Object,
+				Relation:  tuple.Relation,
+				Subject:   tuple.Subject,
+			},
+			traversal.Rewrite,
+			restDepth-1,
+		)
+	})
+}
+
+// forEachTraversedSubjectSet returns a check that is a member if any of the
+// checks returned by check is, for the subject sets that the tuple's object has
+// the relation with.
+func (e *Engine) forEachTraversedSubjectSet(
+	tuple *relationTuple,
+	relation string,
+	check func(ctx context.Context, subSet *relationtuple.SubjectSet) checkgroup.CheckFunc,
+) checkgroup.CheckFunc {
 	return func(ctx context.Context, resultCh chan<- checkgroup.Result) {
 		var (
 			prevPage, nextPage string
@@ -234,7 +309,7 @@ func (e *Engine) checkTupleToSubjectSet(
 				&query{
 					Namespace: &tuple.Namespace,
 					Object:    &tuple.Object,
-					Relation:  &subjectSet.Relation,
+					Relation:  &relation,
 				},
 				x.WithToken(prevPage))
 			if err != nil {
@@ -244,17 +319,7 @@ func (e *Engine) checkTupleToSubjectSet(
 
 			for _, t := range tuples {
 				if subSet, ok := t.Subject.(*relationtuple.SubjectSet); ok {
-					g.Add(e.checkIsAllowed(
-						ctx,
-						&relationTuple{
-							Namespace: subSet.Namespace,
-							Object:    subSet.Object,
-							Relation:  subjectSet.ComputedSubjectSetRelation,
-							Subject:   tuple.Subject,
-						},
-						restDepth-1,
-					))
-
+					g.Add(check(ctx, subSet))
 				}
 			}
 		}
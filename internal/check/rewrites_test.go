@@ -17,6 +17,7 @@ import (
 	"github.com/ory/keto/internal/namespace"
 	"github.com/ory/keto/internal/namespace/ast"
 	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/schema"
 	"github.com/ory/keto/ketoapi"
 )
 
@@ -264,6 +265,117 @@ func TestUsersetRewrites(t *testing.T) {
 	})
 }
 
+func TestTraversals(t *testing.T) {
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class Org implements Namespace {
+  related: {
+    admins: User[]
+    members: User[]
+    banned: User[]
+  }
+}
+class Folder implements Namespace {
+  related: {
+    org: Org[]
+    viewers: User[]
+  }
+}
+class Doc implements Namespace {
+  related: {
+    parents: Folder[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.parents.traverse((p) =>
+      p.related.viewers.includes(ctx.subject) ||
+      p.related.org.traverse((o) => o.related.admins.includes(ctx.subject)),
+    ),
+    edit: (ctx: Context) => this.related.parents.traverse((p) =>
+      p.related.org.traverse((o) => o.related.members.includes(ctx.subject) && !o.related.banned.includes(ctx.subject))),
+  }
+}
+`)
+	require.Empty(t, errs)
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+	reg := newDepsProvider(t, namespaces)
+	insertFixtures(t, reg.RelationTupleManager(), []string{
+		"Doc:d#parents@Folder:f#",
+		"Folder:f#viewers@viewer",
+		"Folder:f#org@Org:o#",
+		"Org:o#admins@admin",
+		"Org:o#members@member",
+		"Org:o#members@banned",
+		"Org:o#banned@banned",
+	})
+
+	e := check.NewEngine(reg)
+	for _, tc := range []struct {
+		query    string
+		expected checkgroup.Membership
+	}{
+		{query: "Doc:d#view@viewer", expected: checkgroup.IsMember},
+		{query: "Doc:d#view@admin", expected: checkgroup.IsMember},
+		{query: "Doc:d#view@member", expected: checkgroup.NotMember},
+		{query: "Doc:d#edit@member", expected: checkgroup.IsMember},
+		{query: "Doc:d#edit@banned", expected: checkgroup.NotMember},
+		{query: "Doc:d#edit@admin", expected: checkgroup.NotMember},
+	} {
+		t.Run("query="+tc.query, func(t *testing.T) {
+			res := e.CheckRelationTuple(context.Background(), tupleFromString(t, tc.query), 100)
+			require.NoError(t, res.Err)
+			assert.Equal(t, tc.expected, res.Membership)
+		})
+	}
+}
+
+func TestSubjectTypes(t *testing.T) {
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class ServiceAccount implements Namespace {}
+class Doc implements Namespace {
+  related: {
+    viewers: (User | ServiceAccount)[]
+  }
+  permits = {
+    view: (ctx: Context<User>) => this.related.viewers.includes(ctx.subject),
+    read: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`)
+	require.Empty(t, errs)
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+	reg := newDepsProvider(t, namespaces)
+	insertFixtures(t, reg.RelationTupleManager(), []string{
+		"Doc:d#viewers@User:u#",
+		"Doc:d#viewers@ServiceAccount:s#",
+		"Doc:d#viewers@id",
+	})
+
+	e := check.NewEngine(reg)
+	for _, tc := range []struct {
+		query    string
+		expected checkgroup.Membership
+	}{
+		{query: "Doc:d#view@User:u#", expected: checkgroup.IsMember},
+		{query: "Doc:d#view@ServiceAccount:s#", expected: checkgroup.NotMember},
+		{query: "Doc:d#read@ServiceAccount:s#", expected: checkgroup.IsMember},
+		// subject IDs are not typed
+		{query: "Doc:d#view@id", expected: checkgroup.IsMember},
+	} {
+		t.Run("query="+tc.query, func(t *testing.T) {
+			res := e.CheckRelationTuple(context.Background(), tupleFromString(t, tc.query), 100)
+			require.NoError(t, res.Err)
+			assert.Equal(t, tc.expected, res.Membership)
+		})
+	}
+}
+
 // assertPath asserts that the given path can be found in the tree.
 func assertPath(t *testing.T, path path, tree *ketoapi.Tree[*relationtuple.RelationTuple]) {
 	require.NotNil(t, tree)
@@ -0,0 +1,112 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ory/keto/internal/check/checkgroup"
+	"github.com/ory/keto/internal/x"
+)
+
+const (
+	shadowOutcomeMatch    = "match"
+	shadowOutcomeMismatch = "mismatch"
+	shadowOutcomeError    = "error"
+	shadowOutcomeSkipped  = "skipped"
+
+	// maxConcurrentShadowChecks bounds the background work, so that shadow
+	// evaluation can not overload an instance. Checks that are sampled while
+	// all slots are taken are skipped.
+	maxConcurrentShadowChecks = 64
+	shadowCheckTimeout        = 10 * time.Second
+)
+
+var shadowChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "keto_shadow_checks_total",
+	Help: "Number of checks that were evaluated against the candidate namespaces, by whether the candidate decided like the active namespaces.",
+}, []string{"namespace", "outcome"})
+
+// checkIsMember checks the relation tuple against the active namespaces, and
+// shadow evaluates a sample of the checks against the candidate namespaces.
+func (h *Handler) checkIsMember(ctx context.Context, r *relationTuple, maxDepth int) (bool, error) {
+	res := h.d.PermissionEngine().CheckRelationTuple(ctx, r, maxDepth)
+	if res.Err != nil {
+		return false, res.Err
+	}
+	h.shadow(ctx, r, maxDepth, res)
+	return res.Membership == checkgroup.IsMember, nil
+}
+
+// shadow evaluates the check against the candidate namespaces in the
+// background, if it is sampled. A decision that differs from the active one is
+// logged with both check trees.
+func (h *Handler) shadow(ctx context.Context, r *relationTuple, maxDepth int, active checkgroup.Result) {
+	c := h.d.Config(ctx)
+	if rand.Float64() >= c.ShadowSampleRate() { //#nosec G404 -- sampling does not need a secure random source
+		return
+	}
+	nm, err := c.CandidateNamespaceManager()
+	if err != nil {
+		h.d.Logger().WithError(err).Warn("Could not load the candidate namespaces for shadow evaluation.")
+		shadowChecks.WithLabelValues(r.Namespace, shadowOutcomeError).Inc()
+		return
+	} else if nm == nil {
+		return
+	}
+
+	select {
+	case h.shadowSlots <- struct{}{}:
+	default:
+		shadowChecks.WithLabelValues(r.Namespace, shadowOutcomeSkipped).Inc()
+		return
+	}
+
+	go func() {
+		defer func() { <-h.shadowSlots }()
+
+		ctx, cancel := context.WithTimeout(x.DetachedContext(ctx), shadowCheckTimeout)
+		defer cancel()
+
+		request := r.String()
+		if t, err := h.d.Mapper().ToTuple(ctx, r); err == nil {
+			request = t[0].String()
+		}
+
+		candidate := NewEngine(h.d, WithNamespaceManager(nm)).CheckRelationTuple(ctx, r, maxDepth)
+		if candidate.Err != nil {
+			h.d.Logger().
+				WithError(candidate.Err).
+				WithField("request", request).
+				Warn("Could not evaluate the check against the candidate namespaces.")
+			shadowChecks.WithLabelValues(r.Namespace, shadowOutcomeError).Inc()
+			return
+		}
+
+		activeAllowed := active.Membership == checkgroup.IsMember
+		candidateAllowed := candidate.Membership == checkgroup.IsMember
+		if activeAllowed == candidateAllowed {
+			shadowChecks.WithLabelValues(r.Namespace, shadowOutcomeMatch).Inc()
+			return
+		}
+		shadowChecks.WithLabelValues(r.Namespace, shadowOutcomeMismatch).Inc()
+
+		l := h.d.Logger().
+			WithField("request", request).
+			WithField("active_allowed", activeAllowed).
+			WithField("candidate_allowed", candidateAllowed)
+		if tree, err := h.d.Mapper().ToCheckTree(ctx, active.Tree); err == nil {
+			l = l.WithField("active_tree", tree)
+		}
+		if tree, err := h.d.Mapper().ToCheckTree(ctx, candidate.Tree); err == nil {
+			l = l.WithField("candidate_tree", tree)
+		}
+		l.Warn("The candidate namespaces decided the check differently.")
+	}()
+}
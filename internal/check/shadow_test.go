@@ -0,0 +1,150 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package check_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ory/x/pointerx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/check"
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	activeNamespaces = `
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    owners: User[]
+    viewers: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject) || this.related.owners.includes(ctx.subject),
+  }
+}`
+	candidateNamespaces = `
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    owners: User[]
+    viewers: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.owners.includes(ctx.subject),
+  }
+}`
+)
+
+func shadowChecks(t *testing.T, outcome string) (sum float64) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "keto_shadow_checks_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "outcome" && l.GetValue() == outcome {
+					sum += m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return sum
+}
+
+func TestShadowEvaluation(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	active, candidate := filepath.Join(dir, "active.ts"), filepath.Join(dir, "candidate.ts")
+	require.NoError(t, os.WriteFile(active, []byte(activeNamespaces), 0600))
+	require.NoError(t, os.WriteFile(candidate, []byte(candidateNamespaces), 0600))
+
+	reg := driver.NewSqliteTestRegistry(t, false)
+	require.NoError(t, reg.Config(ctx).Set(config.KeyNamespaces, map[string]any{"location": "file://" + active}))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyShadowLocation, "file://"+candidate))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyShadowSampleRate, 1))
+	hook := test.NewLocal(reg.Logger().Logger)
+
+	stored := []*ketoapi.RelationTuple{
+		{Namespace: "Document", Object: "d", Relation: "viewers", SubjectID: pointerx.Ptr("bob")},
+		{Namespace: "Document", Object: "d", Relation: "owners", SubjectID: pointerx.Ptr("alice")},
+	}
+	its, err := reg.Mapper().FromTuple(ctx, stored...)
+	require.NoError(t, err)
+	require.NoError(t, reg.RelationTupleManager().WriteRelationTuples(ctx, its...))
+
+	h := check.NewHandler(reg)
+	checkView := func(t *testing.T, subject string) bool {
+		// canceling the request releases the remaining branches of the check
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		res, err := h.Check(ctx, &rts.CheckRequest{Tuple: &rts.RelationTuple{
+			Namespace: "Document",
+			Object:    "d",
+			Relation:  "view",
+			Subject:   rts.NewSubjectID(subject),
+		}})
+		require.NoError(t, err)
+		return res.Allowed
+	}
+
+	t.Run("case=mismatch is logged and counted", func(t *testing.T) {
+		before := shadowChecks(t, "mismatch")
+
+		assert.True(t, checkView(t, "bob"), "the active decision is returned")
+
+		require.Eventually(t, func() bool {
+			return shadowChecks(t, "mismatch") == before+1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		var entry *logrus.Entry
+		for _, e := range hook.AllEntries() {
+			if e.Message == "The candidate namespaces decided the check differently." {
+				entry = e
+			}
+		}
+		require.NotNil(t, entry)
+		assert.Equal(t, "Document:d#view@bob", entry.Data["request"])
+		assert.Equal(t, true, entry.Data["active_allowed"])
+		assert.Equal(t, false, entry.Data["candidate_allowed"])
+		assert.Contains(t, entry.Data, "active_tree")
+		assert.Contains(t, entry.Data, "candidate_tree")
+	})
+
+	t.Run("case=match is counted", func(t *testing.T) {
+		before := shadowChecks(t, "match")
+
+		assert.True(t, checkView(t, "alice"))
+
+		require.Eventually(t, func() bool {
+			return shadowChecks(t, "match") == before+1
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("case=not sampled", func(t *testing.T) {
+		require.NoError(t, reg.Config(ctx).Set(config.KeyShadowSampleRate, 0))
+		t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyShadowSampleRate, 1)) })
+		before := shadowChecks(t, "mismatch")
+
+		assert.True(t, checkView(t, "bob"))
+
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, before, shadowChecks(t, "mismatch"))
+	})
+}
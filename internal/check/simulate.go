@@ -0,0 +1,150 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	SimulateRouteBase = RouteBase + "/simulate"
+
+	// maxSimulatedChecks limits the work of a single simulation, as every
+	// check is evaluated twice.
+	maxSimulatedChecks = 1000
+)
+
+// overlayDependencies are the engine dependencies with the relationships of an
+// overlay manager.
+type overlayDependencies struct {
+	EngineDependencies
+	m relationtuple.Manager
+}
+
+func (d *overlayDependencies) RelationTupleManager() relationtuple.Manager {
+	return d.m
+}
+
+func (h *Handler) Simulate(ctx context.Context, req *rts.SimulateRequest) (*rts.SimulateResponse, error) {
+	r, err := (&ketoapi.SimulateCheckRequest{}).FromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.simulate(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return res.ToProto(), nil
+}
+
+// Simulate Check Request Parameters
+//
+// swagger:parameters simulateCheck
+type simulateCheck struct {
+	// in: body
+	Body ketoapi.SimulateCheckRequest
+}
+
+// swagger:route POST /relation-tuples/check/simulate permission simulateCheck
+//
+// # Simulate Changes to Relationships
+//
+// Evaluates the checks before and after applying the deltas, and returns the
+// checks whose decision changes. The deltas are only applied in memory,
+// nothing is written.
+//
+//	Consumes:
+//	-  application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: simulateCheckResult
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) postSimulate(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var req ketoapi.SimulateCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+
+	res, err := h.simulate(r.Context(), &req)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	h.d.Writer().Write(w, r, res)
+}
+
+func (h *Handler) simulate(ctx context.Context, req *ketoapi.SimulateCheckRequest) (*ketoapi.SimulateCheckResponse, error) {
+	if len(req.Checks) > maxSimulatedChecks {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("At most %d checks can be simulated at once.", maxSimulatedChecks))
+	}
+
+	var insert, remove []*ketoapi.RelationTuple
+	for _, d := range req.Deltas {
+		if d == nil || d.RelationTuple == nil {
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithError("relation_tuple is missing"))
+		}
+		switch d.Action {
+		case ketoapi.ActionInsert:
+			insert = append(insert, d.RelationTuple)
+		case ketoapi.ActionDelete:
+			remove = append(remove, d.RelationTuple)
+		default:
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithError("unknown action " + string(d.Action)))
+		}
+	}
+	for _, c := range req.Checks {
+		if c == nil {
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithError("check is missing"))
+		}
+	}
+
+	deltas, err := h.d.Mapper().FromTuple(ctx, append(insert, remove...)...)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := h.d.Mapper().FromTuple(ctx, req.Checks...)
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := relationtuple.NewOverlayManager(h.d.RelationTupleManager(), deltas[:len(insert)], deltas[len(insert):])
+	simulated := NewEngine(&overlayDependencies{EngineDependencies: h.d, m: overlay})
+
+	res := &ketoapi.SimulateCheckResponse{Flipped: []*ketoapi.SimulatedCheck{}}
+	for i, c := range checks {
+		before, err := h.d.PermissionEngine().CheckIsMember(ctx, c, req.MaxDepth)
+		if err != nil {
+			return nil, err
+		}
+		after, err := simulated.CheckIsMember(ctx, c, req.MaxDepth)
+		if err != nil {
+			return nil, err
+		}
+		if before != after {
+			res.Flipped = append(res.Flipped, &ketoapi.SimulatedCheck{
+				RelationTuple: req.Checks[i],
+				AllowedBefore: before,
+				AllowedAfter:  after,
+			})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,195 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/check"
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}`)
+	require.Len(t, errs, 0)
+	nspaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		nspaces[i] = &nn[i]
+	}
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces(nspaces))
+
+	tuple := func(s string) *ketoapi.RelationTuple {
+		rt, err := (&ketoapi.RelationTuple{}).FromString(s)
+		require.NoError(t, err)
+		return rt
+	}
+	stored := []*ketoapi.RelationTuple{
+		tuple("Document:readme#viewers@Group:eng#members"),
+		tuple("Group:eng#members@bob"),
+		tuple("Document:spec#viewers@alice"),
+	}
+	its, err := reg.Mapper().FromTuple(ctx, stored...)
+	require.NoError(t, err)
+	require.NoError(t, reg.RelationTupleManager().WriteRelationTuples(ctx, its...))
+
+	deltas := []*ketoapi.PatchDelta{
+		{Action: ketoapi.ActionDelete, RelationTuple: tuple("Group:eng#members@bob")},
+		{Action: ketoapi.ActionInsert, RelationTuple: tuple("Document:spec#viewers@bob")},
+	}
+	checks := []*ketoapi.RelationTuple{
+		tuple("Document:readme#view@bob"),
+		tuple("Document:spec#view@bob"),
+		tuple("Document:spec#view@alice"),
+		tuple("Document:readme#view@carl"),
+	}
+	expected := []*ketoapi.SimulatedCheck{
+		{RelationTuple: checks[0], AllowedBefore: true, AllowedAfter: false},
+		{RelationTuple: checks[1], AllowedBefore: false, AllowedAfter: true},
+	}
+
+	assertUnchanged := func(t *testing.T) {
+		actual, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+		require.NoError(t, err)
+		mapped, err := reg.Mapper().ToTuple(ctx, actual...)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, stored, mapped)
+	}
+
+	h := check.NewHandler(reg)
+
+	t.Run("proto=REST", func(t *testing.T) {
+		r := &x.ReadRouter{Router: httprouter.New()}
+		h.RegisterReadRoutes(r)
+		ts := httptest.NewServer(r)
+		t.Cleanup(ts.Close)
+
+		simulate := func(t *testing.T, req any) *http.Response {
+			body, err := json.Marshal(req)
+			require.NoError(t, err)
+			resp, err := ts.Client().Post(ts.URL+check.SimulateRouteBase, "application/json", bytes.NewReader(body))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = resp.Body.Close() })
+			return resp
+		}
+
+		t.Run("case=returns flipped checks", func(t *testing.T) {
+			resp := simulate(t, &ketoapi.SimulateCheckRequest{Deltas: deltas, Checks: checks})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var res ketoapi.SimulateCheckResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			assert.Equal(t, expected, res.Flipped)
+			assertUnchanged(t)
+		})
+
+		t.Run("case=no flips", func(t *testing.T) {
+			resp := simulate(t, &ketoapi.SimulateCheckRequest{Checks: checks})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var res ketoapi.SimulateCheckResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			assert.NotNil(t, res.Flipped)
+			assert.Empty(t, res.Flipped)
+		})
+
+		t.Run("case=unknown action", func(t *testing.T) {
+			resp := simulate(t, &ketoapi.SimulateCheckRequest{
+				Deltas: []*ketoapi.PatchDelta{{Action: "update", RelationTuple: tuple("Document:spec#viewers@bob")}},
+				Checks: checks,
+			})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+
+		t.Run("case=unknown namespace", func(t *testing.T) {
+			resp := simulate(t, &ketoapi.SimulateCheckRequest{Checks: []*ketoapi.RelationTuple{tuple("Folder:f#view@bob")}})
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		})
+	})
+
+	t.Run("proto=gRPC", func(t *testing.T) {
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer(grpc.UnaryInterceptor(herodot.UnaryErrorUnwrapInterceptor))
+		h.RegisterReadGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		client := rts.NewCheckServiceClient(conn)
+
+		t.Run("case=returns flipped checks", func(t *testing.T) {
+			req := &rts.SimulateRequest{
+				RelationTupleDeltas: []*rts.RelationTupleDelta{
+					{Action: rts.RelationTupleDelta_ACTION_DELETE, RelationTuple: deltas[0].RelationTuple.ToProto()},
+					{Action: rts.RelationTupleDelta_ACTION_INSERT, RelationTuple: deltas[1].RelationTuple.ToProto()},
+				},
+			}
+			for _, c := range checks {
+				req.Checks = append(req.Checks, c.ToProto())
+			}
+
+			res, err := client.Simulate(ctx, req)
+			require.NoError(t, err)
+			require.Len(t, res.Flipped, 2)
+			for i, f := range res.Flipped {
+				assert.Equal(t, expected[i].RelationTuple, (&ketoapi.RelationTuple{}).FromProto(f.Tuple))
+				assert.Equal(t, expected[i].AllowedBefore, f.AllowedBefore)
+				assert.Equal(t, expected[i].AllowedAfter, f.AllowedAfter)
+			}
+			assertUnchanged(t)
+		})
+
+		t.Run("case=unspecified action", func(t *testing.T) {
+			_, err := client.Simulate(ctx, &rts.SimulateRequest{
+				RelationTupleDeltas: []*rts.RelationTupleDelta{{RelationTuple: deltas[1].RelationTuple.ToProto()}},
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/logrusx"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/schema"
+)
+
+type (
+	// databaseNamespaceWatcher loads the namespaces from the latest OPL
+	// schema version stored in the database, and polls for new versions.
+	databaseNamespaceWatcher struct {
+		config *Config
+		logger *logrusx.Logger
+
+		version     int
+		versionLock sync.Mutex
+
+		memoryNamespaceManager
+	}
+)
+
+var _ namespace.Manager = (*databaseNamespaceWatcher)(nil)
+
+func newDatabaseNamespaceWatcher(ctx context.Context, c *Config, interval time.Duration) *databaseNamespaceWatcher {
+	nw := &databaseNamespaceWatcher{
+		config:                 c,
+		logger:                 c.l,
+		memoryNamespaceManager: *NewMemoryNamespaceManager(),
+	}
+
+	if err := nw.refresh(ctx); err != nil {
+		nw.logger.WithError(err).Error("Failed to load the OPL schema from the database.")
+	}
+	go nw.poll(ctx, interval)
+
+	return nw
+}
+
+func (nw *databaseNamespaceWatcher) poll(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := nw.refresh(ctx); err != nil {
+				nw.logger.WithError(err).Error("Failed to load the OPL schema from the database.")
+			}
+		}
+	}
+}
+
+// refresh loads the latest schema version from the database, if it is newer
+// than the currently active one. Nothing is loaded before the registry set the
+// schema version manager.
+func (nw *databaseNamespaceWatcher) refresh(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			nw.config.schemaLoadFailed(err)
+		}
+	}()
+
+	m := nw.config.getSchemaVersionManager()
+	if m == nil {
+		return nil
+	}
+
+	v, err := m.GetSchemaVersion(ctx, 0)
+	if errors.Is(err, herodot.ErrNotFound) {
+		nw.config.clearSchemaLoadError()
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	nw.versionLock.Lock()
+	defer nw.versionLock.Unlock()
+
+	if v.Version == nw.version {
+		// the database might have been unreachable before
+		nw.config.clearSchemaLoadError()
+		return nil
+	}
+
+	nn, errs := schema.Parse(v.Content)
+	if len(errs) > 0 {
+		return errors.Wrapf(errs[0], "could not parse the OPL schema version %d", v.Version)
+	}
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+
+	nw.set(namespaces)
+	nw.version = v.Version
+	nw.config.schemaLoaded(schemaHash([]byte(v.Content)), v.Version)
+	nw.logger.Infof("Loaded the OPL schema version %d from the database.", v.Version)
+	return nil
+}
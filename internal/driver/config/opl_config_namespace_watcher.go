@@ -4,15 +4,18 @@
 package config
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/urlx"
 	"github.com/ory/x/watcherx"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
 
 	"github.com/ory/keto/internal/namespace"
 	"github.com/ory/keto/internal/schema"
@@ -25,9 +28,15 @@ type (
 	}
 
 	oplConfigWatcher struct {
+		ctx    context.Context
+		config *Config
 		logger *logrusx.Logger
 		target string
 		files  configFiles
+		// candidate watchers load the namespaces for shadow evaluation, so
+		// they neither consult the schema change guard nor report the status
+		// of the active schema.
+		candidate bool
 
 		memoryNamespaceManager
 	}
@@ -36,10 +45,21 @@ type (
 var _ namespace.Manager = (*oplConfigWatcher)(nil)
 
 func newOPLConfigWatcher(ctx context.Context, c *Config, target string) (*oplConfigWatcher, error) {
+	return newOPLWatcher(ctx, c, target, false)
+}
+
+func newCandidateOPLConfigWatcher(ctx context.Context, c *Config, target string) (*oplConfigWatcher, error) {
+	return newOPLWatcher(ctx, c, target, true)
+}
+
+func newOPLWatcher(ctx context.Context, c *Config, target string, candidate bool) (*oplConfigWatcher, error) {
 	nw := &oplConfigWatcher{
+		ctx:                    ctx,
+		config:                 c,
 		logger:                 c.l,
 		target:                 target,
 		files:                  configFiles{byPath: make(map[string]io.Reader)},
+		candidate:              candidate,
 		memoryNamespaceManager: *NewMemoryNamespaceManager(),
 	}
 
@@ -94,14 +114,20 @@ func (nw *oplConfigWatcher) handleError(e *watcherx.ErrorEvent) {
 func (nw *oplConfigWatcher) parseFiles() {
 	var (
 		namespaces = make([]*namespace.Namespace, 0)
+		contents   = make([][]byte, 0, len(nw.files.byPath))
 		errs       []error
 	)
-	for _, reader := range nw.files.byPath {
-		content, err := io.ReadAll(reader)
+	paths := maps.Keys(nw.files.byPath)
+	sort.Strings(paths)
+	for _, path := range paths {
+		content, err := io.ReadAll(nw.files.byPath[path])
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
+		// the reader is consumed, so we keep the content for the next parse
+		nw.files.byPath[path] = bytes.NewReader(content)
+		contents = append(contents, content)
 		nn, ee := schema.Parse(string(content))
 		for _, e := range ee {
 			errs = append(errs, e)
@@ -118,7 +144,26 @@ func (nw *oplConfigWatcher) parseFiles() {
 				Errorf("Failed to parse OPL config files at target %s.",
 					nw.target)
 		}
+		if !nw.candidate {
+			nw.config.schemaLoadFailed(errs[0])
+		}
+		return
+	}
+	if nw.candidate {
+		nw.set(namespaces)
 		return
 	}
+	if guard := nw.config.getSchemaChangeGuard(); guard != nil {
+		current, _ := nw.Namespaces(nw.ctx)
+		if err := guard(nw.ctx, current, namespaces); err != nil {
+			nw.logger.
+				WithError(err).
+				Errorf("Rejected the OPL config files at target %s, keeping the previous namespaces.",
+					nw.target)
+			nw.config.schemaLoadFailed(err)
+			return
+		}
+	}
 	nw.set(namespaces)
+	nw.config.schemaLoaded(schemaHash(contents...), 0)
 }
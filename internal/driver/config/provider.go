@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ory/x/fetcher"
 	"github.com/ory/x/httpx"
@@ -36,7 +37,8 @@ const (
 	EndpointMetrics   EndpointType = "metrics"
 	EndpointOPLSyntax EndpointType = "opl"
 
-	KeyDSN = "dsn"
+	KeyDSN     = "dsn"
+	KeyReadDSN = "dsn_read"
 
 	KeyLimitMaxReadDepth = "limit.max_read_depth"
 
@@ -49,7 +51,29 @@ const (
 	KeyMetricsHost      = "serve." + string(EndpointMetrics) + ".host"
 	KeyMetricsPort      = "serve." + string(EndpointMetrics) + ".port"
 
-	KeyNamespaces = "namespaces"
+	KeyNamespaces                       = "namespaces"
+	KeyNamespacesRejectOrphaningChanges = KeyNamespaces + ".reject_orphaning_changes"
+	KeyNamespacesSource                 = KeyNamespaces + ".source"
+	KeyNamespacesPollInterval           = KeyNamespaces + ".poll_interval"
+	KeyNamespacesStrictReadiness        = KeyNamespaces + ".strict_readiness"
+
+	KeyShadowLocation   = "shadow.location"
+	KeyShadowSampleRate = "shadow.sample_rate"
+
+	KeyWatchPollInterval = "watch.poll_interval"
+	KeyWatchRetention    = "watch.retention"
+
+	KeyHistoryEnabled   = "history.enabled"
+	KeyHistoryRetention = "history.retention"
+
+	KeyAuditEnabled     = "audit.enabled"
+	KeyAuditActorHeader = "audit.actor_header"
+
+	KeyIdempotencyWindow = "idempotency.window"
+	KeyIdempotencyLease  = "idempotency.lease"
+
+	NamespacesSourceLocation = "location"
+	NamespacesSourceDatabase = "database"
 
 	DSNMemory = "sqlite://file::memory:?_fk=true&cache=shared"
 )
@@ -63,10 +87,29 @@ type (
 		nm                     namespace.Manager
 		cancelNamespaceManager context.CancelFunc
 		nmLock                 sync.Mutex
+
+		schemaChangeGuard     SchemaChangeGuard
+		schemaChangeGuardLock sync.RWMutex
+
+		schemaVersionManager     namespace.SchemaVersionManager
+		schemaVersionManagerLock sync.RWMutex
+
+		schemaStatus     SchemaStatus
+		schemaStatusLock sync.RWMutex
+
+		candidate       namespace.Manager
+		candidateTarget string
+		cancelCandidate context.CancelFunc
+		candidateLock   sync.Mutex
 	}
 	Provider interface {
 		Config(ctx context.Context) *Config
 	}
+
+	// SchemaChangeGuard decides whether the namespaces parsed from an updated
+	// OPL file may replace the currently active ones. Returning an error keeps
+	// the current namespaces.
+	SchemaChangeGuard func(ctx context.Context, current, updated []*namespace.Namespace) error
 )
 
 func New(ctx context.Context, l *logrusx.Logger, p *configx.Provider) *Config {
@@ -95,8 +138,8 @@ func NewProvider(ctx context.Context, flags *pflag.FlagSet, config *Config, opts
 		append(opts,
 			configx.WithFlags(flags),
 			configx.WithStderrValidationReporter(),
-			configx.WithImmutables(KeyDSN, "serve"),
-			configx.OmitKeysFromTracing(KeyDSN),
+			configx.WithImmutables(KeyDSN, KeyReadDSN, "serve"),
+			configx.OmitKeysFromTracing(KeyDSN, KeyReadDSN),
 			configx.WithLogrusWatcher(config.l),
 			configx.WithContext(ctx),
 			configx.AttachWatcher(config.watcher),
@@ -150,6 +193,7 @@ func (k *Config) resetNamespaceManager() {
 	// the next read request will result in a new one being created
 	k.cancelNamespaceManager()
 	k.nm, k.cancelNamespaceManager = nil, nil
+	k.resetSchemaStatus()
 }
 
 func (k *Config) Set(key string, v any) error {
@@ -163,6 +207,56 @@ func (k *Config) Set(key string, v any) error {
 	return nil
 }
 
+// SetSchemaChangeGuard sets the guard that is consulted on every reload of the
+// OPL namespace configuration.
+func (k *Config) SetSchemaChangeGuard(g SchemaChangeGuard) {
+	k.schemaChangeGuardLock.Lock()
+	defer k.schemaChangeGuardLock.Unlock()
+	k.schemaChangeGuard = g
+}
+
+func (k *Config) getSchemaChangeGuard() SchemaChangeGuard {
+	k.schemaChangeGuardLock.RLock()
+	defer k.schemaChangeGuardLock.RUnlock()
+	return k.schemaChangeGuard
+}
+
+// CheckSchemaChange consults the schema change guard, if one is set.
+func (k *Config) CheckSchemaChange(ctx context.Context, current, updated []*namespace.Namespace) error {
+	if guard := k.getSchemaChangeGuard(); guard != nil {
+		return guard(ctx, current, updated)
+	}
+	return nil
+}
+
+// SetSchemaVersionManager sets the store of the OPL schema versions, which is
+// used if the namespaces are loaded from the database.
+func (k *Config) SetSchemaVersionManager(m namespace.SchemaVersionManager) {
+	k.schemaVersionManagerLock.Lock()
+	defer k.schemaVersionManagerLock.Unlock()
+	k.schemaVersionManager = m
+}
+
+func (k *Config) getSchemaVersionManager() namespace.SchemaVersionManager {
+	k.schemaVersionManagerLock.RLock()
+	defer k.schemaVersionManagerLock.RUnlock()
+	return k.schemaVersionManager
+}
+
+// RefreshNamespaces loads the latest OPL schema version from the database
+// right away, instead of waiting for the next poll. It does nothing if the
+// namespaces are not loaded from the database.
+func (k *Config) RefreshNamespaces(ctx context.Context) error {
+	k.nmLock.Lock()
+	nm := k.nm
+	k.nmLock.Unlock()
+
+	if w, ok := nm.(*databaseNamespaceWatcher); ok {
+		return w.refresh(ctx)
+	}
+	return nil
+}
+
 func (k *Config) addressFor(endpoint EndpointType) string {
 	return fmt.Sprintf(
 		"%s:%d",
@@ -180,6 +274,77 @@ func (k *Config) MaxReadDepth() int {
 	return k.p.Int(KeyLimitMaxReadDepth)
 }
 
+// RejectOrphaningSchemaChanges returns whether OPL reloads that would orphan
+// stored relationships should be rejected.
+func (k *Config) RejectOrphaningSchemaChanges() bool {
+	return k.p.Bool(KeyNamespacesRejectOrphaningChanges)
+}
+
+// NamespacesSource returns where the OPL config is loaded from, either
+// NamespacesSourceLocation or NamespacesSourceDatabase.
+func (k *Config) NamespacesSource() string {
+	return k.p.StringF(KeyNamespacesSource, NamespacesSourceLocation)
+}
+
+func (k *Config) NamespacesPollInterval() time.Duration {
+	return k.p.DurationF(KeyNamespacesPollInterval, 5*time.Second)
+}
+
+// ShadowSampleRate returns the share of checks that are also evaluated against
+// the candidate namespaces.
+func (k *Config) ShadowSampleRate() float64 {
+	return k.p.Float64F(KeyShadowSampleRate, 0.1)
+}
+
+// WatchPollInterval returns how often watchers check the changelog for new
+// changes.
+func (k *Config) WatchPollInterval() time.Duration {
+	return k.p.DurationF(KeyWatchPollInterval, time.Second)
+}
+
+// WatchRetention returns how long changes are kept in the changelog.
+func (k *Config) WatchRetention() time.Duration {
+	return k.p.DurationF(KeyWatchRetention, 7*24*time.Hour)
+}
+
+// IdempotencyWindow returns how long the outcomes of requests with an
+// idempotency key are stored.
+func (k *Config) IdempotencyWindow() time.Duration {
+	return k.p.DurationF(KeyIdempotencyWindow, 24*time.Hour)
+}
+
+// IdempotencyLease returns how long a request with an idempotency key holds
+// the key while it is in progress. Retries after the lease take the key over,
+// so that a request that never completed does not block the key for the whole
+// window.
+func (k *Config) IdempotencyLease() time.Duration {
+	return k.p.DurationF(KeyIdempotencyLease, 10*time.Second)
+}
+
+// AuditEnabled returns whether writes of relationships are recorded in the
+// audit log.
+func (k *Config) AuditEnabled() bool {
+	return k.p.Bool(KeyAuditEnabled)
+}
+
+// AuditActorHeader returns the HTTP header and gRPC metadata key that names the
+// actor of a write, if configured.
+func (k *Config) AuditActorHeader() string {
+	return k.p.String(KeyAuditActorHeader)
+}
+
+// HistoryEnabled returns whether deleted relationships are kept as tombstones,
+// so that they can be read as of a time in the past.
+func (k *Config) HistoryEnabled() bool {
+	return k.p.Bool(KeyHistoryEnabled)
+}
+
+// HistoryRetention returns how far back relationships can be read, and how
+// long tombstones are kept.
+func (k *Config) HistoryRetention() time.Duration {
+	return k.p.DurationF(KeyHistoryRetention, 30*24*time.Hour)
+}
+
 func (k *Config) CORS(iface string) (cors.Options, bool) {
 	switch iface {
 	case "read", "write", "metrics":
@@ -203,6 +368,12 @@ func (k *Config) DSN() string {
 	return dsn
 }
 
+// ReadDSN returns the data source name of the read replica, or the empty
+// string if reads go to the primary database.
+func (k *Config) ReadDSN() string {
+	return k.p.String(KeyReadDSN)
+}
+
 func (k *Config) Fetcher() *fetcher.Fetcher {
 	var opts []httpx.ResilientOptions
 	if k.p.Bool("clients.http.disallow_private_ip_ranges") {
@@ -247,6 +418,43 @@ func (k *Config) NamespaceManager() (namespace.Manager, error) {
 	return k.nm, nil
 }
 
+// WithNamespaces returns a config that reads the same values, but only knows
+// the given namespaces.
+func (k *Config) WithNamespaces(nn []*namespace.Namespace) *Config {
+	c := New(k.ctx, k.l, k.p)
+	c.nm = NewMemoryNamespaceManager(nn...)
+	return c
+}
+
+// CandidateNamespaceManager returns the namespaces of the candidate OPL config
+// that checks are shadow evaluated against, or nil if there is none.
+func (k *Config) CandidateNamespaceManager() (namespace.Manager, error) {
+	target := k.p.String(KeyShadowLocation)
+
+	k.candidateLock.Lock()
+	defer k.candidateLock.Unlock()
+
+	if target != k.candidateTarget && k.cancelCandidate != nil {
+		k.cancelCandidate()
+		k.candidate, k.cancelCandidate = nil, nil
+	}
+	k.candidateTarget = target
+	if target == "" {
+		return nil, nil
+	}
+
+	if k.candidate == nil {
+		ctx, cancel := context.WithCancel(k.ctx)
+		nm, err := newCandidateOPLConfigWatcher(ctx, k, target)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		k.candidate, k.cancelCandidate = nm, cancel
+	}
+	return k.candidate, nil
+}
+
 type (
 	buildNamespaceFn func(context.Context, *Config) (namespace.Manager, error)
 
@@ -282,6 +490,9 @@ func (namespaces literalNamespaceConfig) value() any {
 
 func (oplConfig oplNamespaceConfig) newManager() buildNamespaceFn {
 	return func(ctx context.Context, c *Config) (namespace.Manager, error) {
+		if c.NamespacesSource() == NamespacesSourceDatabase {
+			return newDatabaseNamespaceWatcher(ctx, c, c.NamespacesPollInterval()), nil
+		}
 		entry, ok := oplConfig["location"]
 		if !ok {
 			return nil, errors.New("location key not found")
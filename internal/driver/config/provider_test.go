@@ -9,9 +9,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gobuffalo/httptest"
+	"github.com/ory/herodot"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/logrusx"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -237,3 +240,125 @@ namespaces:
 		})
 	}
 }
+
+type schemaVersionStore struct {
+	versions []*namespace.SchemaVersion
+	sync.Mutex
+}
+
+func (s *schemaVersionStore) AddSchemaVersion(_ context.Context, content string) (*namespace.SchemaVersion, error) {
+	s.Lock()
+	defer s.Unlock()
+	v := &namespace.SchemaVersion{Version: len(s.versions) + 1, Content: content}
+	s.versions = append(s.versions, v)
+	return v, nil
+}
+
+func (s *schemaVersionStore) GetSchemaVersion(_ context.Context, version int) (*namespace.SchemaVersion, error) {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.versions) == 0 {
+		return nil, herodot.ErrNotFound
+	}
+	if version == 0 {
+		return s.versions[len(s.versions)-1], nil
+	}
+	return s.versions[version-1], nil
+}
+
+func (s *schemaVersionStore) ListSchemaVersions(context.Context) ([]*namespace.SchemaVersion, error) {
+	panic("not implemented")
+}
+
+func TestDatabaseNamespaceConfig(t *testing.T) {
+	ctx := context.Background()
+	store := &schemaVersionStore{}
+	_, err := store.AddSchemaVersion(ctx, "class User implements Namespace {}")
+	require.NoError(t, err)
+
+	_, p := setup(t, createFile(t, `
+dsn: memory
+namespaces:
+  source: database
+  poll_interval: 10ms`))
+	assert.Equal(t, NamespacesSourceDatabase, p.NamespacesSource())
+	p.SetSchemaVersionManager(store)
+
+	nm, err := p.NamespaceManager()
+	require.NoError(t, err)
+	_, err = nm.GetNamespaceByName(ctx, "User")
+	require.NoError(t, err)
+
+	t.Run("case=polls for new versions", func(t *testing.T) {
+		_, err := store.AddSchemaVersion(ctx, "class Group implements Namespace {}")
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			_, err := nm.GetNamespaceByName(ctx, "Group")
+			return err == nil
+		}, time.Second, 10*time.Millisecond)
+		_, err = nm.GetNamespaceByName(ctx, "User")
+		assert.Error(t, err)
+	})
+
+	t.Run("case=refreshes right away", func(t *testing.T) {
+		_, err := store.AddSchemaVersion(ctx, "class Document implements Namespace {}")
+		require.NoError(t, err)
+		require.NoError(t, p.RefreshNamespaces(ctx))
+		_, err = nm.GetNamespaceByName(ctx, "Document")
+		assert.NoError(t, err)
+	})
+
+	t.Run("case=invalid versions keep the active namespaces", func(t *testing.T) {
+		_, err := store.AddSchemaVersion(ctx, "class Broken implements Namespace {")
+		require.NoError(t, err)
+		assert.Error(t, p.RefreshNamespaces(ctx))
+		_, err = nm.GetNamespaceByName(ctx, "Document")
+		assert.NoError(t, err)
+	})
+}
+
+func TestCandidateNamespaceConfig(t *testing.T) {
+	ctx := context.Background()
+	active := createFile(t, "class User implements Namespace {}")
+	candidate := createFile(t, "class User implements Namespace {}\nclass Group implements Namespace {}")
+
+	_, p := setup(t, createFileF(t, `
+dsn: memory
+namespaces:
+  location: file://%s`, active))
+
+	t.Run("case=disabled without location", func(t *testing.T) {
+		nm, err := p.CandidateNamespaceManager()
+		require.NoError(t, err)
+		assert.Nil(t, nm)
+		assert.Equal(t, 0.1, p.ShadowSampleRate())
+	})
+
+	t.Run("case=loads the candidate namespaces", func(t *testing.T) {
+		require.NoError(t, p.Set(KeyShadowLocation, "file://"+candidate))
+		require.NoError(t, p.Set(KeyShadowSampleRate, 0.5))
+		assert.Equal(t, 0.5, p.ShadowSampleRate())
+
+		nm, err := p.CandidateNamespaceManager()
+		require.NoError(t, err)
+		_, err = nm.GetNamespaceByName(ctx, "Group")
+		assert.NoError(t, err)
+
+		// the active namespaces and their status are not affected
+		assertNamespaces(t, p, &namespace.Namespace{Name: "User"})
+		assert.Equal(t, schemaHash([]byte("class User implements Namespace {}")), p.SchemaStatus().Hash)
+	})
+
+	t.Run("case=invalid candidate does not affect the schema status", func(t *testing.T) {
+		broken := createFile(t, "class Broken implements Namespace {")
+		require.NoError(t, p.Set(KeyShadowLocation, "file://"+broken))
+
+		nm, err := p.CandidateNamespaceManager()
+		require.NoError(t, err)
+		namespaces, err := nm.Namespaces(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, namespaces)
+		assert.Empty(t, p.SchemaStatus().LastError)
+	})
+}
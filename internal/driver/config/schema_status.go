@@ -0,0 +1,87 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// SchemaStatus describes the OPL schema that is currently active, and
+	// whether the last attempt to load a schema failed.
+	SchemaStatus struct {
+		// Hash is the SHA-256 hash of the active schema. It is empty if no
+		// schema is loaded.
+		Hash string
+		// Version is the version of the active schema, if it was loaded from
+		// the database.
+		Version  int
+		LoadedAt time.Time
+		// LastError is the error of the last failed load. If it is set, the
+		// active schema is stale.
+		LastError   error
+		LastErrorAt time.Time
+	}
+)
+
+func schemaHash(content ...[]byte) string {
+	h := sha256.New()
+	for _, c := range content {
+		_, _ = h.Write(c)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SchemaStatus returns the status of the active OPL schema.
+func (k *Config) SchemaStatus() SchemaStatus {
+	k.schemaStatusLock.RLock()
+	defer k.schemaStatusLock.RUnlock()
+	return k.schemaStatus
+}
+
+// SchemaReady returns an error if strict readiness is enabled and the last
+// attempt to load the OPL schema failed.
+func (k *Config) SchemaReady() error {
+	if !k.p.Bool(KeyNamespacesStrictReadiness) {
+		return nil
+	}
+	if err := k.SchemaStatus().LastError; err != nil {
+		return errors.Wrap(err, "the OPL schema could not be loaded")
+	}
+	return nil
+}
+
+func (k *Config) schemaLoaded(hash string, version int) {
+	k.schemaStatusLock.Lock()
+	defer k.schemaStatusLock.Unlock()
+	k.schemaStatus = SchemaStatus{
+		Hash:     hash,
+		Version:  version,
+		LoadedAt: time.Now(),
+	}
+}
+
+func (k *Config) schemaLoadFailed(err error) {
+	k.schemaStatusLock.Lock()
+	defer k.schemaStatusLock.Unlock()
+	k.schemaStatus.LastError = err
+	k.schemaStatus.LastErrorAt = time.Now()
+}
+
+func (k *Config) clearSchemaLoadError() {
+	k.schemaStatusLock.Lock()
+	defer k.schemaStatusLock.Unlock()
+	k.schemaStatus.LastError = nil
+	k.schemaStatus.LastErrorAt = time.Time{}
+}
+
+func (k *Config) resetSchemaStatus() {
+	k.schemaStatusLock.Lock()
+	defer k.schemaStatusLock.Unlock()
+	k.schemaStatus = SchemaStatus{}
+}
@@ -18,7 +18,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/ory/keto/internal/namespace/namespacehandler"
+	"github.com/ory/keto/internal/namespace/playground"
 	"github.com/ory/keto/internal/schema"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 
 	prometheus "github.com/ory/x/prometheusx"
@@ -36,8 +38,10 @@ import (
 	grpcHealthV1 "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/ory/keto/internal/audit"
 	"github.com/ory/keto/internal/check"
 	"github.com/ory/keto/internal/expand"
+	"github.com/ory/keto/internal/idempotency"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
 
@@ -313,6 +317,8 @@ func (r *RegistryDefault) allHandlers() []Handler {
 			expand.NewHandler(r),
 			namespacehandler.New(r),
 			schema.NewHandler(r),
+			playground.NewHandler(r),
+			audit.NewHandler(r),
 		}
 	}
 	return r.handlers
@@ -330,7 +336,7 @@ func (r *RegistryDefault) ReadRouter(ctx context.Context) http.Handler {
 	r.MetricsHandler().SetRoutes(br.Router)
 
 	r.HealthHandler().SetHealthRoutes(br.Router, false)
-	r.HealthHandler().SetVersionRoutes(br.Router)
+	br.GET(healthx.VersionPath, r.getVersion)
 
 	for _, h := range r.allHandlers() {
 		if h, ok := h.(ReadHandler); ok {
@@ -360,13 +366,15 @@ func (r *RegistryDefault) WriteRouter(ctx context.Context) http.Handler {
 		n.UseFunc(f)
 	}
 	n.Use(reqlog.NewMiddlewareFromLogger(r.l, "write#Ory Keto").ExcludePaths(healthx.AliveCheckPath, healthx.ReadyCheckPath))
+	n.UseFunc(audit.HTTPMiddleware(r))
+	n.UseFunc(idempotency.HTTPMiddleware(r))
 
 	pr := &x.WriteRouter{Router: httprouter.New()}
 	r.PrometheusManager().RegisterRouter(pr.Router)
 	r.MetricsHandler().SetRoutes(pr.Router)
 
 	r.HealthHandler().SetHealthRoutes(pr.Router, false)
-	r.HealthHandler().SetVersionRoutes(pr.Router)
+	pr.GET(healthx.VersionPath, r.getVersion)
 
 	for _, h := range r.allHandlers() {
 		if h, ok := h.(WriteHandler); ok {
@@ -402,7 +410,7 @@ func (r *RegistryDefault) OPLSyntaxRouter(ctx context.Context) http.Handler {
 	r.MetricsHandler().SetRoutes(pr.Router)
 
 	r.HealthHandler().SetHealthRoutes(pr.Router, false)
-	r.HealthHandler().SetVersionRoutes(pr.Router)
+	pr.GET(healthx.VersionPath, r.getVersion)
 
 	for _, h := range r.allHandlers() {
 		if h, ok := h.(OPLSyntaxHandler); ok {
@@ -435,7 +443,7 @@ func (r *RegistryDefault) grpcRecoveryHandler(p interface{}) error {
 	return status.Errorf(codes.Internal, "%v", p)
 }
 
-func (r *RegistryDefault) unaryInterceptors(ctx context.Context) []grpc.UnaryServerInterceptor {
+func (r *RegistryDefault) unaryInterceptors(ctx context.Context, extra ...grpc.UnaryServerInterceptor) []grpc.UnaryServerInterceptor {
 	is := []grpc.UnaryServerInterceptor{
 		grpcRecovery.UnaryServerInterceptor(grpcRecovery.WithRecoveryHandler(r.grpcRecoveryHandler)),
 	}
@@ -446,7 +454,9 @@ func (r *RegistryDefault) unaryInterceptors(ctx context.Context) []grpc.UnarySer
 	is = append(is,
 		herodot.UnaryErrorUnwrapInterceptor,
 		grpcLogrus.UnaryServerInterceptor(r.l.Entry),
+		audit.UnaryServerInterceptor(r),
 	)
+	is = append(is, extra...)
 	if r.sqaService != nil {
 		is = append(is, r.sqaService.UnaryInterceptor)
 	}
@@ -464,6 +474,7 @@ func (r *RegistryDefault) streamInterceptors(ctx context.Context) []grpc.StreamS
 	is = append(is,
 		herodot.StreamErrorUnwrapInterceptor,
 		grpcLogrus.StreamServerInterceptor(r.l.Entry),
+		audit.StreamServerInterceptor(r),
 	)
 	if r.sqaService != nil {
 		is = append(is, r.sqaService.StreamInterceptor)
@@ -471,10 +482,10 @@ func (r *RegistryDefault) streamInterceptors(ctx context.Context) []grpc.StreamS
 	return is
 }
 
-func (r *RegistryDefault) newGrpcServer(ctx context.Context) *grpc.Server {
+func (r *RegistryDefault) newGrpcServer(ctx context.Context, unary ...grpc.UnaryServerInterceptor) *grpc.Server {
 	opts := []grpc.ServerOption{
 		grpc.ChainStreamInterceptor(r.streamInterceptors(ctx)...),
-		grpc.ChainUnaryInterceptor(r.unaryInterceptors(ctx)...),
+		grpc.ChainUnaryInterceptor(r.unaryInterceptors(ctx, unary...)...),
 	}
 	if r.grpcTransportCredentials != nil {
 		opts = append(opts, grpc.Creds(r.grpcTransportCredentials))
@@ -499,7 +510,12 @@ func (r *RegistryDefault) ReadGRPCServer(ctx context.Context) *grpc.Server {
 }
 
 func (r *RegistryDefault) WriteGRPCServer(ctx context.Context) *grpc.Server {
-	s := r.newGrpcServer(ctx)
+	// Only the services that write relationships or schemas claim idempotency
+	// keys, reads on the write port are executed as usual.
+	s := r.newGrpcServer(ctx, idempotency.UnaryServerInterceptor(r,
+		rts.WriteService_ServiceDesc.ServiceName,
+		opl.SchemaService_ServiceDesc.ServiceName,
+	))
 
 	grpcHealthV1.RegisterHealthServer(s, r.HealthServer())
 	rts.RegisterVersionServiceServer(s, r)
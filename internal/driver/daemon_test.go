@@ -4,11 +4,17 @@
 package driver
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
@@ -23,10 +29,12 @@ import (
 
 	"context"
 
+	"github.com/ory/x/healthx"
 	prometheus "github.com/ory/x/prometheusx"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ory/keto/internal/x/dbx"
+	"github.com/ory/keto/ketoapi"
 )
 
 const (
@@ -101,3 +109,64 @@ func TestPanicRecovery(t *testing.T) {
 	<-doneShutdown
 	require.NoError(t, eg.Wait())
 }
+
+func TestSchemaStatus(t *testing.T) {
+	ctx := context.Background()
+
+	valid := "class User implements Namespace {}"
+	fn := filepath.Join(t.TempDir(), "namespaces.ts")
+	require.NoError(t, os.WriteFile(fn, []byte(valid), 0600))
+
+	r := NewSqliteTestRegistry(t, false)
+	require.NoError(t, r.Config(ctx).Set(config.KeyNamespaces, map[string]any{
+		"location":         "file://" + fn,
+		"strict_readiness": true,
+	}))
+	_, err := r.Config(ctx).NamespaceManager()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(r.ReadRouter(ctx))
+	t.Cleanup(server.Close)
+
+	getVersion := func(t *testing.T) *ketoapi.VersionResponse {
+		resp, err := server.Client().Get(server.URL + healthx.VersionPath)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var v ketoapi.VersionResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&v))
+		return &v
+	}
+	getReady := func(t *testing.T) int {
+		resp, err := server.Client().Get(server.URL + healthx.ReadyCheckPath)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	hash := sha256.Sum256([]byte(valid))
+	v := getVersion(t)
+	assert.Equal(t, config.Version, v.Version)
+	assert.Equal(t, hex.EncodeToString(hash[:]), v.Schema.Hash)
+	assert.NotNil(t, v.Schema.LoadedAt)
+	assert.Empty(t, v.Schema.LastError)
+	assert.Equal(t, http.StatusOK, getReady(t))
+
+	require.NoError(t, os.WriteFile(fn, []byte("class Broken implements Namespace {"), 0600))
+	assert.Eventually(t, func() bool {
+		return getReady(t) == http.StatusServiceUnavailable
+	}, 5*time.Second, 10*time.Millisecond)
+
+	v = getVersion(t)
+	assert.Equal(t, hex.EncodeToString(hash[:]), v.Schema.Hash, "the previous schema stays active")
+	assert.NotEmpty(t, v.Schema.LastError)
+	assert.NotNil(t, v.Schema.LastErrorAt)
+
+	res, err := r.GetVersion(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(hash[:]), res.Schema.Hash)
+	assert.NotEmpty(t, res.Schema.LastError)
+
+	t.Run("case=lenient readiness", func(t *testing.T) {
+		require.NoError(t, r.Config(ctx).Set(config.KeyNamespacesStrictReadiness, false))
+		assert.Equal(t, http.StatusOK, getReady(t))
+	})
+}
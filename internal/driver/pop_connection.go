@@ -16,7 +16,11 @@ import (
 )
 
 func (r *RegistryDefault) PopConnectionWithOpts(ctx context.Context, popOpts ...func(*pop.ConnectionDetails)) (*pop.Connection, error) {
-	pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(r.Logger(), r.Config(ctx).DSN())
+	return r.openPopConnection(ctx, r.Config(ctx).DSN(), popOpts...)
+}
+
+func (r *RegistryDefault) openPopConnection(ctx context.Context, dsn string, popOpts ...func(*pop.ConnectionDetails)) (*pop.Connection, error) {
+	pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(r.Logger(), dsn)
 	connDetails := &pop.ConnectionDetails{
 		URL:                   sqlcon.FinalizeDSN(r.Logger(), cleanedDSN),
 		IdlePool:              idlePool,
@@ -69,6 +73,20 @@ func (r *RegistryDefault) PopConnectionWithOpts(ctx context.Context, popOpts ...
 	return conn.WithContext(ctx), nil
 }
 
+// Close closes the database connections of the registry. In-memory databases
+// are discarded with their last connection.
+func (r *RegistryDefault) Close() error {
+	if r.readConn != nil && r.readConn != r.conn {
+		if err := r.readConn.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if r.conn == nil {
+		return nil
+	}
+	return errors.WithStack(r.conn.Close())
+}
+
 // PopConnection returns the standard connection that is kept for the whole time.
 func (r *RegistryDefault) PopConnection(ctx context.Context) (*pop.Connection, error) {
 	if r.conn == nil {
@@ -78,3 +96,25 @@ func (r *RegistryDefault) PopConnection(ctx context.Context) (*pop.Connection, e
 	}
 	return r.conn, nil
 }
+
+// ReadPopConnection returns the connection to the read replica that is kept for
+// the whole time. Without a read replica, it is the standard connection.
+func (r *RegistryDefault) ReadPopConnection(ctx context.Context) (*pop.Connection, error) {
+	if r.readConn != nil {
+		return r.readConn, nil
+	}
+
+	dsn := r.Config(ctx).ReadDSN()
+	if dsn == "" {
+		conn, err := r.PopConnection(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.readConn = conn
+		return conn, nil
+	}
+
+	var err error
+	r.readConn, err = r.openPopConnection(ctx, dsn)
+	return r.readConn, err
+}
@@ -0,0 +1,195 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/persistence/sql"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func dialBufconn(t *testing.T, s *grpc.Server) *grpc.ClientConn {
+	l := bufconn.Listen(1024 * 1024)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			t.Logf("Server exited with error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestReadReplica(t *testing.T) {
+	ctx := context.Background()
+	nspaces := WithNamespaces([]*namespace.Namespace{{Name: "n"}})
+
+	// The replica is a separate database that only gets the writes we
+	// replicate explicitly, so it lags behind the primary.
+	replica := NewSqliteTestRegistry(t, false, nspaces)
+	r := NewSqliteTestRegistry(t, false, nspaces, func(t testing.TB, r *RegistryDefault) {
+		require.NoError(t, r.c.Set(config.KeyReadDSN, replica.Config(ctx).DSN()))
+	})
+
+	network, err := r.DetermineNetwork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, replica.Persister().Connection(ctx).Create(network))
+	replicaPersister, err := sql.NewPersister(ctx, replica, network.ID)
+	require.NoError(t, err)
+	replicate := func(t *testing.T, tuples ...*ketoapi.RelationTuple) {
+		its, err := r.Mapper().FromTuple(ctx, tuples...)
+		require.NoError(t, err)
+		for _, rt := range tuples {
+			_, err := replicaPersister.MapStringsToUUIDs(ctx, rt.Object, *rt.SubjectID)
+			require.NoError(t, err)
+		}
+		require.NoError(t, replicaPersister.WriteRelationTuples(ctx, its...))
+	}
+
+	read := func(t *testing.T, ctx context.Context) []*relationtuple.RelationTuple {
+		rs, _, err := r.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: pointerx.Ptr("n")})
+		require.NoError(t, err)
+		return rs
+	}
+
+	tuple := &ketoapi.RelationTuple{Namespace: "n", Object: "o", Relation: "r", SubjectID: pointerx.Ptr("s")}
+	write := rts.NewWriteServiceClient(dialBufconn(t, r.WriteGRPCServer(ctx)))
+	resp, err := write.TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+		RelationTupleDeltas: []*rts.RelationTupleDelta{
+			{Action: rts.RelationTupleDelta_ACTION_INSERT, RelationTuple: tuple.ToProto()},
+			{Action: rts.RelationTupleDelta_ACTION_DELETE, RelationTuple: (&ketoapi.RelationTuple{Namespace: "n", Object: "o", Relation: "r", SubjectID: pointerx.Ptr("other")}).ToProto()},
+		},
+	})
+	require.NoError(t, err)
+	// one snaptoken per inserted relationship, of the transaction that
+	// applied the deltas
+	require.Len(t, resp.Snaptokens, 1)
+	snaptoken := resp.Snaptokens[0]
+	latest, err := r.SnaptokenManager().Snaptoken(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest, snaptoken)
+
+	t.Run("case=reads go to the replica", func(t *testing.T) {
+		assert.Empty(t, read(t, ctx))
+		assert.Len(t, read(t, relationtuple.WithPrimary(ctx)), 1)
+	})
+
+	t.Run("case=snaptokens fall back to the primary", func(t *testing.T) {
+		sctx, err := relationtuple.WithSnaptoken(ctx, r, snaptoken)
+		require.NoError(t, err)
+		assert.True(t, relationtuple.ReadsPrimary(sctx))
+		assert.Len(t, read(t, sctx), 1)
+
+		_, err = relationtuple.WithSnaptoken(ctx, r, "malformed")
+		assert.ErrorIs(t, err, herodot.ErrBadRequest)
+	})
+
+	t.Run("case=handlers respect snaptokens", func(t *testing.T) {
+		ts := httptest.NewServer(r.ReadRouter(ctx))
+		t.Cleanup(ts.Close)
+
+		list := func(t *testing.T, snaptoken string) *ketoapi.GetResponse {
+			resp, err := ts.Client().Get(ts.URL + relationtuple.ReadRouteBase + "?" + url.Values{"namespace": {"n"}, "snaptoken": {snaptoken}}.Encode())
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			var res ketoapi.GetResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			return &res
+		}
+		assert.Empty(t, list(t, "").RelationTuples)
+		assert.Equal(t, []*ketoapi.RelationTuple{tuple}, list(t, snaptoken).RelationTuples)
+
+		resp, err := ts.Client().Get(ts.URL + relationtuple.ReadRouteBase + "?" + url.Values{"namespace": {"n"}, "snaptoken": {"malformed"}}.Encode())
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		readConn := dialBufconn(t, r.ReadGRPCServer(ctx))
+		expand := rts.NewExpandServiceClient(readConn)
+		subjects := &rts.Subject{Ref: &rts.Subject_Set{Set: &rts.SubjectSet{Namespace: "n", Object: "o", Relation: "r"}}}
+		tree, err := expand.Expand(ctx, &rts.ExpandRequest{Subject: subjects})
+		require.NoError(t, err)
+		assert.Empty(t, tree.GetTree().GetChildren())
+		tree, err = expand.Expand(ctx, &rts.ExpandRequest{Subject: subjects, Snaptoken: snaptoken})
+		require.NoError(t, err)
+		assert.Len(t, tree.GetTree().GetChildren(), 1)
+
+		check := rts.NewCheckServiceClient(readConn)
+		res, err := check.Check(ctx, &rts.CheckRequest{Tuple: tuple.ToProto()})
+		require.NoError(t, err)
+		assert.False(t, res.Allowed)
+		assert.Empty(t, res.Snaptoken)
+
+		res, err = check.Check(ctx, &rts.CheckRequest{Tuple: tuple.ToProto(), Snaptoken: snaptoken})
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+
+		res, err = check.Check(ctx, &rts.CheckRequest{Tuple: tuple.ToProto(), Latest: true})
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, snaptoken, res.Snaptoken)
+	})
+
+	t.Run("case=replicated snaptokens read from the replica", func(t *testing.T) {
+		replicate(t, tuple)
+
+		sctx, err := relationtuple.WithSnaptoken(ctx, r, snaptoken)
+		require.NoError(t, err)
+		assert.False(t, relationtuple.ReadsPrimary(sctx))
+		assert.Len(t, read(t, sctx), 1)
+
+		// the engines and the mapper read from the replica
+		allowed, err := r.PermissionEngine().CheckIsMember(ctx, read(t, ctx)[0], 0)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		mapped, err := r.Mapper().ToTuple(ctx, read(t, ctx)...)
+		require.NoError(t, err)
+		assert.Equal(t, []*ketoapi.RelationTuple{tuple}, mapped)
+	})
+
+	t.Run("case=stale replicas are bypassed for fresher snaptokens", func(t *testing.T) {
+		// the replica has the first write, but not this one
+		fresher := &ketoapi.RelationTuple{Namespace: "n", Object: "o", Relation: "r", SubjectID: pointerx.Ptr("fresher")}
+		resp, err := write.TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+			RelationTupleDeltas: []*rts.RelationTupleDelta{{Action: rts.RelationTupleDelta_ACTION_INSERT, RelationTuple: fresher.ToProto()}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Snaptokens, 1)
+		assert.NotEqual(t, snaptoken, resp.Snaptokens[0])
+
+		check := rts.NewCheckServiceClient(dialBufconn(t, r.ReadGRPCServer(ctx)))
+		res, err := check.Check(ctx, &rts.CheckRequest{Tuple: fresher.ToProto(), Snaptoken: snaptoken})
+		require.NoError(t, err)
+		assert.False(t, res.Allowed, "the replica is fresh enough for the older snaptoken")
+
+		res, err = check.Check(ctx, &rts.CheckRequest{Tuple: fresher.ToProto(), Snaptoken: resp.Snaptokens[0]})
+		require.NoError(t, err)
+		assert.True(t, res.Allowed, "the stale replica must be bypassed")
+	})
+}
@@ -31,12 +31,14 @@ type (
 		x.WriterProvider
 
 		relationtuple.ManagerProvider
+		relationtuple.MapperProvider
 		expand.EngineProvider
 		check.EngineProvider
 		persistence.Migrator
 		persistence.Provider
 
 		PopConnection(ctx context.Context) (*pop.Connection, error)
+		ReadPopConnection(ctx context.Context) (*pop.Connection, error)
 		PopConnectionWithOpts(ctx context.Context, f ...func(*pop.ConnectionDetails)) (*pop.Connection, error)
 
 		HealthHandler() *healthx.Handler
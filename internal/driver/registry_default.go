@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/gobuffalo/pop/v6"
+	"github.com/julienschmidt/httprouter"
 	"github.com/ory/herodot"
 	"github.com/ory/x/dbal"
 	"github.com/ory/x/fsx"
@@ -17,6 +18,7 @@ import (
 	"github.com/ory/x/metricsx"
 	"github.com/ory/x/networkx"
 	"github.com/ory/x/otelx"
+	"github.com/ory/x/pointerx"
 	"github.com/ory/x/popx"
 	prometheus "github.com/ory/x/prometheusx"
 	"github.com/pkg/errors"
@@ -24,14 +26,19 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 
+	"github.com/ory/keto/internal/audit"
 	"github.com/ory/keto/internal/check"
 	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/expand"
+	"github.com/ory/keto/internal/idempotency"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/namespacediff"
 	"github.com/ory/keto/internal/persistence"
 	"github.com/ory/keto/internal/persistence/sql"
 	"github.com/ory/keto/internal/persistence/sql/migrations/uuidmapping"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
 	"github.com/ory/keto/ketoctx"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
@@ -49,16 +56,17 @@ var (
 
 type (
 	RegistryDefault struct {
-		p      persistence.Persister
-		mb     *popx.MigrationBox
-		l      *logrusx.Logger
-		w      herodot.Writer
-		ce     *check.Engine
-		ee     *expand.Engine
-		c      *config.Config
-		conn   *pop.Connection
-		ctxer  ketoctx.Contextualizer
-		mapper *relationtuple.Mapper
+		p        persistence.Persister
+		mb       *popx.MigrationBox
+		l        *logrusx.Logger
+		w        herodot.Writer
+		ce       *check.Engine
+		ee       *expand.Engine
+		c        *config.Config
+		conn     *pop.Connection
+		readConn *pop.Connection
+		ctxer    ketoctx.Contextualizer
+		mapper   *relationtuple.Mapper
 
 		initialized    sync.Once
 		healthH        *healthx.Handler
@@ -110,7 +118,11 @@ func (r *RegistryDefault) Config(ctx context.Context) *config.Config {
 
 func (r *RegistryDefault) HealthHandler() *healthx.Handler {
 	if r.healthH == nil {
-		r.healthH = healthx.NewHandler(r.Writer(), config.Version, healthx.ReadyCheckers{})
+		r.healthH = healthx.NewHandler(r.Writer(), config.Version, healthx.ReadyCheckers{
+			"schema": func(req *http.Request) error {
+				return r.Config(req.Context()).SchemaReady()
+			},
+		})
 	}
 
 	return r.healthH
@@ -124,8 +136,36 @@ func (r *RegistryDefault) HealthServer() *health.Server {
 	return r.healthServer
 }
 
-func (r *RegistryDefault) GetVersion(_ context.Context, _ *rts.GetVersionRequest) (*rts.GetVersionResponse, error) {
-	return &rts.GetVersionResponse{Version: config.Version}, nil
+func (r *RegistryDefault) GetVersion(ctx context.Context, _ *rts.GetVersionRequest) (*rts.GetVersionResponse, error) {
+	return &rts.GetVersionResponse{
+		Version: config.Version,
+		Schema:  r.schemaStatus(ctx).ToProto(),
+	}, nil
+}
+
+// getVersion replaces the version endpoint of the health handler, to also
+// report the status of the OPL schema.
+func (r *RegistryDefault) getVersion(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	r.Writer().Write(w, req, &ketoapi.VersionResponse{
+		Version: config.Version,
+		Schema:  r.schemaStatus(req.Context()),
+	})
+}
+
+func (r *RegistryDefault) schemaStatus(ctx context.Context) *ketoapi.SchemaStatus {
+	s := r.Config(ctx).SchemaStatus()
+	res := &ketoapi.SchemaStatus{
+		Hash:    s.Hash,
+		Version: s.Version,
+	}
+	if !s.LoadedAt.IsZero() {
+		res.LoadedAt = pointerx.Ptr(s.LoadedAt)
+	}
+	if s.LastError != nil {
+		res.LastError = s.LastError.Error()
+		res.LastErrorAt = pointerx.Ptr(s.LastErrorAt)
+	}
+	return res
 }
 
 func (r *RegistryDefault) Tracer(ctx context.Context) *otelx.Tracer {
@@ -183,6 +223,48 @@ func (r *RegistryDefault) MappingManager() relationtuple.MappingManager {
 	return r.p
 }
 
+func (r *RegistryDefault) RelationTupleChangelog() relationtuple.Changelog {
+	if r.p == nil {
+		panic("no changelog, but expected to have one")
+	}
+	return r.p
+}
+
+func (r *RegistryDefault) RelationTupleImporter() relationtuple.Importer {
+	if r.p == nil {
+		panic("no importer, but expected to have one")
+	}
+	return r.p
+}
+
+func (r *RegistryDefault) AuditManager() audit.Manager {
+	if r.p == nil {
+		panic("no audit manager, but expected to have one")
+	}
+	return r.p
+}
+
+func (r *RegistryDefault) ObjectManager() relationtuple.ObjectManager {
+	if r.p == nil {
+		panic("no object manager, but expected to have one")
+	}
+	return r.p
+}
+
+func (r *RegistryDefault) SnaptokenManager() relationtuple.SnaptokenManager {
+	if r.p == nil {
+		panic("no snaptoken manager, but expected to have one")
+	}
+	return r.p
+}
+
+func (r *RegistryDefault) IdempotencyManager() idempotency.Manager {
+	if r.p == nil {
+		panic("no idempotency manager, but expected to have one")
+	}
+	return r.p
+}
+
 func (r *RegistryDefault) Persister() persistence.Persister {
 	if r.p == nil {
 		panic("no persister, but expected to have one")
@@ -301,9 +383,32 @@ func (r *RegistryDefault) Init(ctx context.Context) (err error) {
 			if err != nil {
 				return err
 			}
+			r.c.SetSchemaChangeGuard(r.guardSchemaChange)
+			r.c.SetSchemaVersionManager(r.p)
+			if err := r.c.RefreshNamespaces(ctx); err != nil {
+				r.Logger().WithError(err).Error("Failed to load the OPL schema from the database.")
+			}
 
 			return nil
 		}()
 	})
 	return
 }
+
+// guardSchemaChange rejects OPL updates that would orphan stored relationships,
+// if this is enabled in the config.
+func (r *RegistryDefault) guardSchemaChange(ctx context.Context, current, updated []*namespace.Namespace) error {
+	if !r.Config(ctx).RejectOrphaningSchemaChanges() {
+		return nil
+	}
+	changes := namespacediff.Compute(current, updated)
+	counts, err := r.Persister().CountSubjectTypes(ctx)
+	if err != nil {
+		return err
+	}
+	namespacediff.CountOrphans(changes, counts)
+	if n := namespacediff.TotalOrphans(changes); n > 0 {
+		return errors.Errorf("the updated namespaces would orphan %d stored relationships", n)
+	}
+	return nil
+}
@@ -13,7 +13,9 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/gofrs/uuid"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/dbal"
 	"github.com/ory/x/tlsx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -75,6 +77,39 @@ func NewDefaultRegistry(ctx context.Context, flags *pflag.FlagSet, withoutNetwor
 	return r, nil
 }
 
+// NewInMemoryRegistry creates a registry backed by a new, empty SQLite
+// in-memory database that only knows the given namespaces. It is meant for
+// evaluating schemas and relationships offline, e.g. in OPL tests.
+func NewInMemoryRegistry(ctx context.Context, namespaces []*namespace.Namespace, opts []ketoctx.Option) (*RegistryDefault, error) {
+	options := ketoctx.Options(opts...)
+
+	l := options.Logger()
+	if l == nil {
+		l = newLogger(ctx)
+	}
+
+	ctx = configx.ContextWithConfigOptions(ctx, configx.WithValues(map[string]interface{}{
+		config.KeyDSN:        dbal.NewSQLiteInMemoryDatabase(uuid.Must(uuid.NewV4()).String()),
+		"log.level":          "error",
+		config.KeyNamespaces: namespaces,
+	}))
+	c, err := config.NewDefault(ctx, nil, l)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to initialize config provider")
+	}
+
+	r := &RegistryDefault{
+		c:     c,
+		l:     l,
+		ctxer: &ketoctx.DefaultContextualizer{},
+	}
+	if err := r.Init(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to initialize service registry")
+	}
+
+	return r, nil
+}
+
 func NewSqliteTestRegistry(t testing.TB, debugOnDisk bool, opts ...TestRegistryOption) *RegistryDefault {
 	mode := dbx.SQLiteMemory
 	if debugOnDisk {
@@ -6,6 +6,7 @@ package expand
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/ory/keto/ketoapi"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/ory/herodot"
 	"google.golang.org/grpc"
 
+	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
@@ -23,8 +25,10 @@ type (
 		EngineProvider
 		relationtuple.ManagerProvider
 		relationtuple.MapperProvider
+		relationtuple.SnaptokenManagerProvider
 		x.LoggerProvider
 		x.WriterProvider
+		config.Provider
 	}
 	handler struct {
 		d handlerDependencies
@@ -56,6 +60,17 @@ func (h *handler) RegisterReadGRPC(s *grpc.Server) {
 type expandPermissions struct {
 	// in:query
 	MaxDepth int `json:"max-depth"`
+	// Expands the subject set on the relationships as they were at this
+	// time, in RFC 3339 format. Requires the history mode, and a time within
+	// its retention.
+	//
+	// in:query
+	AsOf *time.Time `json:"as_of"`
+	// Expands the subject set on relationships no older than this snaptoken,
+	// reading from the primary database if the read replica lags behind.
+	//
+	// in:query
+	Snaptoken string `json:"snaptoken"`
 	// in:query
 	ketoapi.SubjectSet
 }
@@ -85,15 +100,30 @@ func (h *handler) getExpand(w http.ResponseWriter, r *http.Request, _ httprouter
 		h.d.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()))
 		return
 	}
+	asOf, err := x.GetAsOfFromQuery(r.URL.Query())
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	ctx, err := relationtuple.WithAsOf(r.Context(), h.d, asOf)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	ctx, err = relationtuple.WithSnaptoken(ctx, h.d, r.URL.Query().Get("snaptoken"))
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
 
 	subSet := (&ketoapi.SubjectSet{}).FromURLQuery(r.URL.Query())
-	internal, err := h.d.Mapper().FromSubjectSet(r.Context(), subSet)
+	internal, err := h.d.Mapper().FromSubjectSet(ctx, subSet)
 	if err != nil {
 		h.d.Writer().WriteError(w, r, err)
 		return
 	}
 
-	res, err := h.d.ExpandEngine().BuildTree(r.Context(), internal, maxDepth)
+	res, err := h.d.ExpandEngine().BuildTree(ctx, internal, maxDepth)
 	if err != nil {
 		h.d.Writer().WriteError(w, r, err)
 		return
@@ -131,6 +161,19 @@ func (h *handler) Expand(ctx context.Context, req *rts.ExpandRequest) (*rts.Expa
 		}
 	}
 
+	asOf, err := relationtuple.AsOfFromProto(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = relationtuple.WithAsOf(ctx, h.d, asOf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = relationtuple.WithSnaptoken(ctx, h.d, req.Snaptoken)
+	if err != nil {
+		return nil, err
+	}
+
 	internal, err := h.d.Mapper().FromSubjectSet(ctx, subSet)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // Linger please
@@ -95,6 +96,22 @@ type PermissionApi interface {
 	 * @return CheckPermissionResult
 	 */
 	PostCheckPermissionOrErrorExecute(r PermissionApiApiPostCheckPermissionOrErrorRequest) (*CheckPermissionResult, *http.Response, error)
+
+	/*
+			 * SimulateCheck Simulate Changes to Relationships
+			 * Evaluates the checks before and after applying the deltas, and returns the
+		checks whose decision changes. The deltas are only applied in memory,
+		nothing is written.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return PermissionApiApiSimulateCheckRequest
+	*/
+	SimulateCheck(ctx context.Context) PermissionApiApiSimulateCheckRequest
+
+	/*
+	 * SimulateCheckExecute executes the request
+	 * @return SimulateCheckResult
+	 */
+	SimulateCheckExecute(r PermissionApiApiSimulateCheckRequest) (*SimulateCheckResult, *http.Response, error)
 }
 
 // PermissionApiService PermissionApi service
@@ -111,6 +128,8 @@ type PermissionApiApiCheckPermissionRequest struct {
 	subjectSetObject    *string
 	subjectSetRelation  *string
 	maxDepth            *int64
+	asOf                *time.Time
+	snaptoken           *string
 }
 
 func (r PermissionApiApiCheckPermissionRequest) Namespace(namespace string) PermissionApiApiCheckPermissionRequest {
@@ -145,6 +164,14 @@ func (r PermissionApiApiCheckPermissionRequest) MaxDepth(maxDepth int64) Permiss
 	r.maxDepth = &maxDepth
 	return r
 }
+func (r PermissionApiApiCheckPermissionRequest) AsOf(asOf time.Time) PermissionApiApiCheckPermissionRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r PermissionApiApiCheckPermissionRequest) Snaptoken(snaptoken string) PermissionApiApiCheckPermissionRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
 
 func (r PermissionApiApiCheckPermissionRequest) Execute() (*CheckPermissionResult, *http.Response, error) {
 	return r.ApiService.CheckPermissionExecute(r)
@@ -212,6 +239,12 @@ func (a *PermissionApiService) CheckPermissionExecute(r PermissionApiApiCheckPer
 	if r.maxDepth != nil {
 		localVarQueryParams.Add("max-depth", parameterToString(*r.maxDepth, ""))
 	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -294,6 +327,8 @@ type PermissionApiApiCheckPermissionOrErrorRequest struct {
 	subjectSetObject    *string
 	subjectSetRelation  *string
 	maxDepth            *int64
+	asOf                *time.Time
+	snaptoken           *string
 }
 
 func (r PermissionApiApiCheckPermissionOrErrorRequest) Namespace(namespace string) PermissionApiApiCheckPermissionOrErrorRequest {
@@ -328,6 +363,14 @@ func (r PermissionApiApiCheckPermissionOrErrorRequest) MaxDepth(maxDepth int64)
 	r.maxDepth = &maxDepth
 	return r
 }
+func (r PermissionApiApiCheckPermissionOrErrorRequest) AsOf(asOf time.Time) PermissionApiApiCheckPermissionOrErrorRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r PermissionApiApiCheckPermissionOrErrorRequest) Snaptoken(snaptoken string) PermissionApiApiCheckPermissionOrErrorRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
 
 func (r PermissionApiApiCheckPermissionOrErrorRequest) Execute() (*CheckPermissionResult, *http.Response, error) {
 	return r.ApiService.CheckPermissionOrErrorExecute(r)
@@ -395,6 +438,12 @@ func (a *PermissionApiService) CheckPermissionOrErrorExecute(r PermissionApiApiC
 	if r.maxDepth != nil {
 		localVarQueryParams.Add("max-depth", parameterToString(*r.maxDepth, ""))
 	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -483,6 +532,8 @@ type PermissionApiApiExpandPermissionsRequest struct {
 	object     *string
 	relation   *string
 	maxDepth   *int64
+	asOf       *time.Time
+	snaptoken  *string
 }
 
 func (r PermissionApiApiExpandPermissionsRequest) Namespace(namespace string) PermissionApiApiExpandPermissionsRequest {
@@ -501,6 +552,14 @@ func (r PermissionApiApiExpandPermissionsRequest) MaxDepth(maxDepth int64) Permi
 	r.maxDepth = &maxDepth
 	return r
 }
+func (r PermissionApiApiExpandPermissionsRequest) AsOf(asOf time.Time) PermissionApiApiExpandPermissionsRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r PermissionApiApiExpandPermissionsRequest) Snaptoken(snaptoken string) PermissionApiApiExpandPermissionsRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
 
 func (r PermissionApiApiExpandPermissionsRequest) Execute() (*ExpandedPermissionTree, *http.Response, error) {
 	return r.ApiService.ExpandPermissionsExecute(r)
@@ -559,6 +618,12 @@ func (a *PermissionApiService) ExpandPermissionsExecute(r PermissionApiApiExpand
 	if r.maxDepth != nil {
 		localVarQueryParams.Add("max-depth", parameterToString(*r.maxDepth, ""))
 	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -644,6 +709,8 @@ type PermissionApiApiPostCheckPermissionRequest struct {
 	ctx                     context.Context
 	ApiService              PermissionApi
 	maxDepth                *int64
+	asOf                    *time.Time
+	snaptoken               *string
 	postCheckPermissionBody *PostCheckPermissionBody
 }
 
@@ -651,6 +718,14 @@ func (r PermissionApiApiPostCheckPermissionRequest) MaxDepth(maxDepth int64) Per
 	r.maxDepth = &maxDepth
 	return r
 }
+func (r PermissionApiApiPostCheckPermissionRequest) AsOf(asOf time.Time) PermissionApiApiPostCheckPermissionRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r PermissionApiApiPostCheckPermissionRequest) Snaptoken(snaptoken string) PermissionApiApiPostCheckPermissionRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
 func (r PermissionApiApiPostCheckPermissionRequest) PostCheckPermissionBody(postCheckPermissionBody PostCheckPermissionBody) PermissionApiApiPostCheckPermissionRequest {
 	r.postCheckPermissionBody = &postCheckPermissionBody
 	return r
@@ -701,6 +776,12 @@ func (a *PermissionApiService) PostCheckPermissionExecute(r PermissionApiApiPost
 	if r.maxDepth != nil {
 		localVarQueryParams.Add("max-depth", parameterToString(*r.maxDepth, ""))
 	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
 
@@ -778,6 +859,8 @@ type PermissionApiApiPostCheckPermissionOrErrorRequest struct {
 	ctx                            context.Context
 	ApiService                     PermissionApi
 	maxDepth                       *int64
+	asOf                           *time.Time
+	snaptoken                      *string
 	postCheckPermissionOrErrorBody *PostCheckPermissionOrErrorBody
 }
 
@@ -785,6 +868,14 @@ func (r PermissionApiApiPostCheckPermissionOrErrorRequest) MaxDepth(maxDepth int
 	r.maxDepth = &maxDepth
 	return r
 }
+func (r PermissionApiApiPostCheckPermissionOrErrorRequest) AsOf(asOf time.Time) PermissionApiApiPostCheckPermissionOrErrorRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r PermissionApiApiPostCheckPermissionOrErrorRequest) Snaptoken(snaptoken string) PermissionApiApiPostCheckPermissionOrErrorRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
 func (r PermissionApiApiPostCheckPermissionOrErrorRequest) PostCheckPermissionOrErrorBody(postCheckPermissionOrErrorBody PostCheckPermissionOrErrorBody) PermissionApiApiPostCheckPermissionOrErrorRequest {
 	r.postCheckPermissionOrErrorBody = &postCheckPermissionOrErrorBody
 	return r
@@ -835,6 +926,12 @@ func (a *PermissionApiService) PostCheckPermissionOrErrorExecute(r PermissionApi
 	if r.maxDepth != nil {
 		localVarQueryParams.Add("max-depth", parameterToString(*r.maxDepth, ""))
 	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json"}
 
@@ -917,3 +1014,132 @@ func (a *PermissionApiService) PostCheckPermissionOrErrorExecute(r PermissionApi
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type PermissionApiApiSimulateCheckRequest struct {
+	ctx               context.Context
+	ApiService        PermissionApi
+	simulateCheckBody *SimulateCheckBody
+}
+
+func (r PermissionApiApiSimulateCheckRequest) SimulateCheckBody(simulateCheckBody SimulateCheckBody) PermissionApiApiSimulateCheckRequest {
+	r.simulateCheckBody = &simulateCheckBody
+	return r
+}
+
+func (r PermissionApiApiSimulateCheckRequest) Execute() (*SimulateCheckResult, *http.Response, error) {
+	return r.ApiService.SimulateCheckExecute(r)
+}
+
+/*
+  - SimulateCheck Simulate Changes to Relationships
+  - Evaluates the checks before and after applying the deltas, and returns the
+
+checks whose decision changes. The deltas are only applied in memory,
+nothing is written.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return PermissionApiApiSimulateCheckRequest
+*/
+func (a *PermissionApiService) SimulateCheck(ctx context.Context) PermissionApiApiSimulateCheckRequest {
+	return PermissionApiApiSimulateCheckRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return SimulateCheckResult
+ */
+func (a *PermissionApiService) SimulateCheckExecute(r PermissionApiApiSimulateCheckRequest) (*SimulateCheckResult, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *SimulateCheckResult
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PermissionApiService.SimulateCheck")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/relation-tuples/check/simulate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.simulateCheckBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // Linger please
@@ -54,6 +55,21 @@ type RelationshipApi interface {
 	 */
 	CreateRelationshipExecute(r RelationshipApiApiCreateRelationshipRequest) (*Relationship, *http.Response, error)
 
+	/*
+			 * DeleteObject Delete an Object
+			 * Deletes all relationships of the object, and all relationships with a
+		subject set of the object, in one transaction.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiDeleteObjectRequest
+	*/
+	DeleteObject(ctx context.Context) RelationshipApiApiDeleteObjectRequest
+
+	/*
+	 * DeleteObjectExecute executes the request
+	 * @return DeleteObjectResult
+	 */
+	DeleteObjectExecute(r RelationshipApiApiDeleteObjectRequest) (*DeleteObjectResult, *http.Response, error)
+
 	/*
 	 * DeleteRelationships Delete Relationships
 	 * Use this endpoint to delete relationships
@@ -67,6 +83,36 @@ type RelationshipApi interface {
 	 */
 	DeleteRelationshipsExecute(r RelationshipApiApiDeleteRelationshipsRequest) (*http.Response, error)
 
+	/*
+	 * DescribeNamespaces Describe namespaces
+	 * Get the relations, allowed subject types, and permit rewrites of all namespaces
+	 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	 * @return RelationshipApiApiDescribeNamespacesRequest
+	 */
+	DescribeNamespaces(ctx context.Context) RelationshipApiApiDescribeNamespacesRequest
+
+	/*
+	 * DescribeNamespacesExecute executes the request
+	 * @return NamespaceSchemas
+	 */
+	DescribeNamespacesExecute(r RelationshipApiApiDescribeNamespacesRequest) (*NamespaceSchemas, *http.Response, error)
+
+	/*
+			 * EvaluateOpl Evaluate an OPL file
+			 * Writes the relationships to a new, empty in-memory store that uses the OPL
+		file as its namespaces, and evaluates the checks and expands against it. The
+		database is not touched.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiEvaluateOplRequest
+	*/
+	EvaluateOpl(ctx context.Context) RelationshipApiApiEvaluateOplRequest
+
+	/*
+	 * EvaluateOplExecute executes the request
+	 * @return EvaluateOplResult
+	 */
+	EvaluateOplExecute(r RelationshipApiApiEvaluateOplRequest) (*EvaluateOplResult, *http.Response, error)
+
 	/*
 	 * GetRelationships Query relationships
 	 * Get all relationships that match the query. Only the namespace field is required.
@@ -81,6 +127,36 @@ type RelationshipApi interface {
 	 */
 	GetRelationshipsExecute(r RelationshipApiApiGetRelationshipsRequest) (*Relationships, *http.Response, error)
 
+	/*
+			 * ListAuditEntries List the audit log of relationship writes
+			 * Lists the recorded writes, the latest first. Writes are only recorded if the
+		audit log is enabled.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiListAuditEntriesRequest
+	*/
+	ListAuditEntries(ctx context.Context) RelationshipApiApiListAuditEntriesRequest
+
+	/*
+	 * ListAuditEntriesExecute executes the request
+	 * @return AuditEntries
+	 */
+	ListAuditEntriesExecute(r RelationshipApiApiListAuditEntriesRequest) (*AuditEntries, *http.Response, error)
+
+	/*
+			 * ListOplSchemaVersions List the stored OPL schema versions
+			 * Lists all stored versions, the active (latest) one first. The content is
+		omitted.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiListOplSchemaVersionsRequest
+	*/
+	ListOplSchemaVersions(ctx context.Context) RelationshipApiApiListOplSchemaVersionsRequest
+
+	/*
+	 * ListOplSchemaVersionsExecute executes the request
+	 * @return SchemaVersions
+	 */
+	ListOplSchemaVersionsExecute(r RelationshipApiApiListOplSchemaVersionsRequest) (*SchemaVersions, *http.Response, error)
+
 	/*
 	 * ListRelationshipNamespaces Query namespaces
 	 * Get all namespaces
@@ -96,17 +172,90 @@ type RelationshipApi interface {
 	ListRelationshipNamespacesExecute(r RelationshipApiApiListRelationshipNamespacesRequest) (*RelationshipNamespaces, *http.Response, error)
 
 	/*
-	 * PatchRelationships Patch Multiple Relationships
-	 * Use this endpoint to patch one or more relationships.
-	 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	 * @return RelationshipApiApiPatchRelationshipsRequest
-	 */
+			 * PatchRelationships Patch Multiple Relationships
+			 * Use this endpoint to patch one or more relationships.
+
+		The body is either a list of changes, or a relationshipPatchWithPreconditions
+		object with the changes and preconditions. The preconditions are checked in
+		the transaction that applies the changes. If any of them does not hold, no
+		change is applied and the endpoint responds with 409.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiPatchRelationshipsRequest
+	*/
 	PatchRelationships(ctx context.Context) RelationshipApiApiPatchRelationshipsRequest
 
 	/*
 	 * PatchRelationshipsExecute executes the request
 	 */
 	PatchRelationshipsExecute(r RelationshipApiApiPatchRelationshipsRequest) (*http.Response, error)
+
+	/*
+			 * RenameObject Rename an Object
+			 * Moves all relationships of the object, and all relationships with a subject
+		set of the object, to the new object ID in one transaction. The new object
+		must not have relationships yet.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiRenameObjectRequest
+	*/
+	RenameObject(ctx context.Context) RelationshipApiApiRenameObjectRequest
+
+	/*
+	 * RenameObjectExecute executes the request
+	 * @return RenameObjectResult
+	 */
+	RenameObjectExecute(r RelationshipApiApiRenameObjectRequest) (*RenameObjectResult, *http.Response, error)
+
+	/*
+			 * RollbackOplSchema Roll back to an earlier OPL schema version
+			 * Stores the content of the given version as a new version, which makes it
+		the active one.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiRollbackOplSchemaRequest
+	*/
+	RollbackOplSchema(ctx context.Context) RelationshipApiApiRollbackOplSchemaRequest
+
+	/*
+	 * RollbackOplSchemaExecute executes the request
+	 * @return SchemaVersion
+	 */
+	RollbackOplSchemaExecute(r RelationshipApiApiRollbackOplSchemaRequest) (*SchemaVersion, *http.Response, error)
+
+	/*
+			 * WatchRelationships Watch relationship changes
+			 * Returns the inserts and deletes of relationships that were committed after
+		the cursor, in commit order. The request waits until there are changes or
+		the timeout is reached (long polling).
+
+		With the `Accept: text/event-stream` header, the changes are streamed as
+		server sent events instead. Every event is one transaction and has the
+		cursor as its ID, so that clients can resume with the `Last-Event-ID`
+		header.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiWatchRelationshipsRequest
+	*/
+	WatchRelationships(ctx context.Context) RelationshipApiApiWatchRelationshipsRequest
+
+	/*
+	 * WatchRelationshipsExecute executes the request
+	 * @return WatchRelationshipsResponse
+	 */
+	WatchRelationshipsExecute(r RelationshipApiApiWatchRelationshipsRequest) (*WatchRelationshipsResponse, *http.Response, error)
+
+	/*
+			 * WriteOplSchema Store a new OPL schema version
+			 * The OPL file is expected in the body of the request. It is only stored if
+		it can be parsed. The stored schema is used if the namespaces are configured
+		to be loaded from the database.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return RelationshipApiApiWriteOplSchemaRequest
+	*/
+	WriteOplSchema(ctx context.Context) RelationshipApiApiWriteOplSchemaRequest
+
+	/*
+	 * WriteOplSchemaExecute executes the request
+	 * @return WriteSchemaResult
+	 */
+	WriteOplSchemaExecute(r RelationshipApiApiWriteOplSchemaRequest) (*WriteSchemaResult, *http.Response, error)
 }
 
 // RelationshipApiService RelationshipApi service
@@ -364,6 +513,155 @@ func (a *RelationshipApiService) CreateRelationshipExecute(r RelationshipApiApiC
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type RelationshipApiApiDeleteObjectRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+	namespace  *string
+	object     *string
+}
+
+func (r RelationshipApiApiDeleteObjectRequest) Namespace(namespace string) RelationshipApiApiDeleteObjectRequest {
+	r.namespace = &namespace
+	return r
+}
+func (r RelationshipApiApiDeleteObjectRequest) Object(object string) RelationshipApiApiDeleteObjectRequest {
+	r.object = &object
+	return r
+}
+
+func (r RelationshipApiApiDeleteObjectRequest) Execute() (*DeleteObjectResult, *http.Response, error) {
+	return r.ApiService.DeleteObjectExecute(r)
+}
+
+/*
+  - DeleteObject Delete an Object
+  - Deletes all relationships of the object, and all relationships with a
+
+subject set of the object, in one transaction.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiDeleteObjectRequest
+*/
+func (a *RelationshipApiService) DeleteObject(ctx context.Context) RelationshipApiApiDeleteObjectRequest {
+	return RelationshipApiApiDeleteObjectRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return DeleteObjectResult
+ */
+func (a *RelationshipApiService) DeleteObjectExecute(r RelationshipApiApiDeleteObjectRequest) (*DeleteObjectResult, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodDelete
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *DeleteObjectResult
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.DeleteObject")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/relation-tuples/objects"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.namespace == nil {
+		return localVarReturnValue, nil, reportError("namespace is required and must be specified")
+	}
+	if r.object == nil {
+		return localVarReturnValue, nil, reportError("object is required and must be specified")
+	}
+
+	localVarQueryParams.Add("namespace", parameterToString(*r.namespace, ""))
+	localVarQueryParams.Add("object", parameterToString(*r.object, ""))
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type RelationshipApiApiDeleteRelationshipsRequest struct {
 	ctx                 context.Context
 	ApiService          RelationshipApi
@@ -528,69 +826,29 @@ func (a *RelationshipApiService) DeleteRelationshipsExecute(r RelationshipApiApi
 	return localVarHTTPResponse, nil
 }
 
-type RelationshipApiApiGetRelationshipsRequest struct {
-	ctx                 context.Context
-	ApiService          RelationshipApi
-	pageToken           *string
-	pageSize            *int64
-	namespace           *string
-	object              *string
-	relation            *string
-	subjectId           *string
-	subjectSetNamespace *string
-	subjectSetObject    *string
-	subjectSetRelation  *string
+type RelationshipApiApiDescribeNamespacesRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+	namespace  *string
 }
 
-func (r RelationshipApiApiGetRelationshipsRequest) PageToken(pageToken string) RelationshipApiApiGetRelationshipsRequest {
-	r.pageToken = &pageToken
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) PageSize(pageSize int64) RelationshipApiApiGetRelationshipsRequest {
-	r.pageSize = &pageSize
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) Namespace(namespace string) RelationshipApiApiGetRelationshipsRequest {
+func (r RelationshipApiApiDescribeNamespacesRequest) Namespace(namespace string) RelationshipApiApiDescribeNamespacesRequest {
 	r.namespace = &namespace
 	return r
 }
-func (r RelationshipApiApiGetRelationshipsRequest) Object(object string) RelationshipApiApiGetRelationshipsRequest {
-	r.object = &object
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) Relation(relation string) RelationshipApiApiGetRelationshipsRequest {
-	r.relation = &relation
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) SubjectId(subjectId string) RelationshipApiApiGetRelationshipsRequest {
-	r.subjectId = &subjectId
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetNamespace(subjectSetNamespace string) RelationshipApiApiGetRelationshipsRequest {
-	r.subjectSetNamespace = &subjectSetNamespace
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetObject(subjectSetObject string) RelationshipApiApiGetRelationshipsRequest {
-	r.subjectSetObject = &subjectSetObject
-	return r
-}
-func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetRelation(subjectSetRelation string) RelationshipApiApiGetRelationshipsRequest {
-	r.subjectSetRelation = &subjectSetRelation
-	return r
-}
 
-func (r RelationshipApiApiGetRelationshipsRequest) Execute() (*Relationships, *http.Response, error) {
-	return r.ApiService.GetRelationshipsExecute(r)
+func (r RelationshipApiApiDescribeNamespacesRequest) Execute() (*NamespaceSchemas, *http.Response, error) {
+	return r.ApiService.DescribeNamespacesExecute(r)
 }
 
 /*
- * GetRelationships Query relationships
- * Get all relationships that match the query. Only the namespace field is required.
+ * DescribeNamespaces Describe namespaces
+ * Get the relations, allowed subject types, and permit rewrites of all namespaces
  * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @return RelationshipApiApiGetRelationshipsRequest
+ * @return RelationshipApiApiDescribeNamespacesRequest
  */
-func (a *RelationshipApiService) GetRelationships(ctx context.Context) RelationshipApiApiGetRelationshipsRequest {
-	return RelationshipApiApiGetRelationshipsRequest{
+func (a *RelationshipApiService) DescribeNamespaces(ctx context.Context) RelationshipApiApiDescribeNamespacesRequest {
+	return RelationshipApiApiDescribeNamespacesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -598,56 +856,32 @@ func (a *RelationshipApiService) GetRelationships(ctx context.Context) Relations
 
 /*
  * Execute executes the request
- * @return Relationships
+ * @return NamespaceSchemas
  */
-func (a *RelationshipApiService) GetRelationshipsExecute(r RelationshipApiApiGetRelationshipsRequest) (*Relationships, *http.Response, error) {
+func (a *RelationshipApiService) DescribeNamespacesExecute(r RelationshipApiApiDescribeNamespacesRequest) (*NamespaceSchemas, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  *Relationships
+		localVarReturnValue  *NamespaceSchemas
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.GetRelationships")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.DescribeNamespaces")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/relation-tuples"
+	localVarPath := localBasePath + "/namespaces/schema"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.pageToken != nil {
-		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
-	}
-	if r.pageSize != nil {
-		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
-	}
 	if r.namespace != nil {
 		localVarQueryParams.Add("namespace", parameterToString(*r.namespace, ""))
 	}
-	if r.object != nil {
-		localVarQueryParams.Add("object", parameterToString(*r.object, ""))
-	}
-	if r.relation != nil {
-		localVarQueryParams.Add("relation", parameterToString(*r.relation, ""))
-	}
-	if r.subjectId != nil {
-		localVarQueryParams.Add("subject_id", parameterToString(*r.subjectId, ""))
-	}
-	if r.subjectSetNamespace != nil {
-		localVarQueryParams.Add("subject_set.namespace", parameterToString(*r.subjectSetNamespace, ""))
-	}
-	if r.subjectSetObject != nil {
-		localVarQueryParams.Add("subject_set.object", parameterToString(*r.subjectSetObject, ""))
-	}
-	if r.subjectSetRelation != nil {
-		localVarQueryParams.Add("subject_set.relation", parameterToString(*r.subjectSetRelation, ""))
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -719,23 +953,1191 @@ func (a *RelationshipApiService) GetRelationshipsExecute(r RelationshipApiApiGet
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type RelationshipApiApiListRelationshipNamespacesRequest struct {
-	ctx        context.Context
+type RelationshipApiApiEvaluateOplRequest struct {
+	ctx             context.Context
+	ApiService      RelationshipApi
+	evaluateOplBody *EvaluateOplBody
+}
+
+func (r RelationshipApiApiEvaluateOplRequest) EvaluateOplBody(evaluateOplBody EvaluateOplBody) RelationshipApiApiEvaluateOplRequest {
+	r.evaluateOplBody = &evaluateOplBody
+	return r
+}
+
+func (r RelationshipApiApiEvaluateOplRequest) Execute() (*EvaluateOplResult, *http.Response, error) {
+	return r.ApiService.EvaluateOplExecute(r)
+}
+
+/*
+  - EvaluateOpl Evaluate an OPL file
+  - Writes the relationships to a new, empty in-memory store that uses the OPL
+
+file as its namespaces, and evaluates the checks and expands against it. The
+database is not touched.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiEvaluateOplRequest
+*/
+func (a *RelationshipApiService) EvaluateOpl(ctx context.Context) RelationshipApiApiEvaluateOplRequest {
+	return RelationshipApiApiEvaluateOplRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return EvaluateOplResult
+ */
+func (a *RelationshipApiService) EvaluateOplExecute(r RelationshipApiApiEvaluateOplRequest) (*EvaluateOplResult, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *EvaluateOplResult
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.EvaluateOpl")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/opl/playground"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.evaluateOplBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiGetRelationshipsRequest struct {
+	ctx                 context.Context
+	ApiService          RelationshipApi
+	pageToken           *string
+	pageSize            *int64
+	namespace           *string
+	object              *string
+	relation            *string
+	subjectId           *string
+	subjectSetNamespace *string
+	subjectSetObject    *string
+	subjectSetRelation  *string
+	asOf                *time.Time
+	snaptoken           *string
+}
+
+func (r RelationshipApiApiGetRelationshipsRequest) PageToken(pageToken string) RelationshipApiApiGetRelationshipsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) PageSize(pageSize int64) RelationshipApiApiGetRelationshipsRequest {
+	r.pageSize = &pageSize
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) Namespace(namespace string) RelationshipApiApiGetRelationshipsRequest {
+	r.namespace = &namespace
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) Object(object string) RelationshipApiApiGetRelationshipsRequest {
+	r.object = &object
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) Relation(relation string) RelationshipApiApiGetRelationshipsRequest {
+	r.relation = &relation
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) SubjectId(subjectId string) RelationshipApiApiGetRelationshipsRequest {
+	r.subjectId = &subjectId
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetNamespace(subjectSetNamespace string) RelationshipApiApiGetRelationshipsRequest {
+	r.subjectSetNamespace = &subjectSetNamespace
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetObject(subjectSetObject string) RelationshipApiApiGetRelationshipsRequest {
+	r.subjectSetObject = &subjectSetObject
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) SubjectSetRelation(subjectSetRelation string) RelationshipApiApiGetRelationshipsRequest {
+	r.subjectSetRelation = &subjectSetRelation
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) AsOf(asOf time.Time) RelationshipApiApiGetRelationshipsRequest {
+	r.asOf = &asOf
+	return r
+}
+func (r RelationshipApiApiGetRelationshipsRequest) Snaptoken(snaptoken string) RelationshipApiApiGetRelationshipsRequest {
+	r.snaptoken = &snaptoken
+	return r
+}
+
+func (r RelationshipApiApiGetRelationshipsRequest) Execute() (*Relationships, *http.Response, error) {
+	return r.ApiService.GetRelationshipsExecute(r)
+}
+
+/*
+ * GetRelationships Query relationships
+ * Get all relationships that match the query. Only the namespace field is required.
+ * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ * @return RelationshipApiApiGetRelationshipsRequest
+ */
+func (a *RelationshipApiService) GetRelationships(ctx context.Context) RelationshipApiApiGetRelationshipsRequest {
+	return RelationshipApiApiGetRelationshipsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return Relationships
+ */
+func (a *RelationshipApiService) GetRelationshipsExecute(r RelationshipApiApiGetRelationshipsRequest) (*Relationships, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *Relationships
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.GetRelationships")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/relation-tuples"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.pageToken != nil {
+		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	}
+	if r.pageSize != nil {
+		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
+	}
+	if r.namespace != nil {
+		localVarQueryParams.Add("namespace", parameterToString(*r.namespace, ""))
+	}
+	if r.object != nil {
+		localVarQueryParams.Add("object", parameterToString(*r.object, ""))
+	}
+	if r.relation != nil {
+		localVarQueryParams.Add("relation", parameterToString(*r.relation, ""))
+	}
+	if r.subjectId != nil {
+		localVarQueryParams.Add("subject_id", parameterToString(*r.subjectId, ""))
+	}
+	if r.subjectSetNamespace != nil {
+		localVarQueryParams.Add("subject_set.namespace", parameterToString(*r.subjectSetNamespace, ""))
+	}
+	if r.subjectSetObject != nil {
+		localVarQueryParams.Add("subject_set.object", parameterToString(*r.subjectSetObject, ""))
+	}
+	if r.subjectSetRelation != nil {
+		localVarQueryParams.Add("subject_set.relation", parameterToString(*r.subjectSetRelation, ""))
+	}
+	if r.asOf != nil {
+		localVarQueryParams.Add("as_of", parameterToString(*r.asOf, ""))
+	}
+	if r.snaptoken != nil {
+		localVarQueryParams.Add("snaptoken", parameterToString(*r.snaptoken, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiListAuditEntriesRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+	pageToken  *string
+	pageSize   *int64
+	actor      *string
+	requestId  *string
+}
+
+func (r RelationshipApiApiListAuditEntriesRequest) PageToken(pageToken string) RelationshipApiApiListAuditEntriesRequest {
+	r.pageToken = &pageToken
+	return r
+}
+func (r RelationshipApiApiListAuditEntriesRequest) PageSize(pageSize int64) RelationshipApiApiListAuditEntriesRequest {
+	r.pageSize = &pageSize
+	return r
+}
+func (r RelationshipApiApiListAuditEntriesRequest) Actor(actor string) RelationshipApiApiListAuditEntriesRequest {
+	r.actor = &actor
+	return r
+}
+func (r RelationshipApiApiListAuditEntriesRequest) RequestId(requestId string) RelationshipApiApiListAuditEntriesRequest {
+	r.requestId = &requestId
+	return r
+}
+
+func (r RelationshipApiApiListAuditEntriesRequest) Execute() (*AuditEntries, *http.Response, error) {
+	return r.ApiService.ListAuditEntriesExecute(r)
+}
+
+/*
+  - ListAuditEntries List the audit log of relationship writes
+  - Lists the recorded writes, the latest first. Writes are only recorded if the
+
+audit log is enabled.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiListAuditEntriesRequest
+*/
+func (a *RelationshipApiService) ListAuditEntries(ctx context.Context) RelationshipApiApiListAuditEntriesRequest {
+	return RelationshipApiApiListAuditEntriesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return AuditEntries
+ */
+func (a *RelationshipApiService) ListAuditEntriesExecute(r RelationshipApiApiListAuditEntriesRequest) (*AuditEntries, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *AuditEntries
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.ListAuditEntries")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/audit-log"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.pageToken != nil {
+		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	}
+	if r.pageSize != nil {
+		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
+	}
+	if r.actor != nil {
+		localVarQueryParams.Add("actor", parameterToString(*r.actor, ""))
+	}
+	if r.requestId != nil {
+		localVarQueryParams.Add("request_id", parameterToString(*r.requestId, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiListOplSchemaVersionsRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+}
+
+func (r RelationshipApiApiListOplSchemaVersionsRequest) Execute() (*SchemaVersions, *http.Response, error) {
+	return r.ApiService.ListOplSchemaVersionsExecute(r)
+}
+
+/*
+  - ListOplSchemaVersions List the stored OPL schema versions
+  - Lists all stored versions, the active (latest) one first. The content is
+
+omitted.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiListOplSchemaVersionsRequest
+*/
+func (a *RelationshipApiService) ListOplSchemaVersions(ctx context.Context) RelationshipApiApiListOplSchemaVersionsRequest {
+	return RelationshipApiApiListOplSchemaVersionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return SchemaVersions
+ */
+func (a *RelationshipApiService) ListOplSchemaVersionsExecute(r RelationshipApiApiListOplSchemaVersionsRequest) (*SchemaVersions, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *SchemaVersions
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.ListOplSchemaVersions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/namespaces/schema/versions"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiListRelationshipNamespacesRequest struct {
+	ctx        context.Context
 	ApiService RelationshipApi
 }
 
-func (r RelationshipApiApiListRelationshipNamespacesRequest) Execute() (*RelationshipNamespaces, *http.Response, error) {
-	return r.ApiService.ListRelationshipNamespacesExecute(r)
+func (r RelationshipApiApiListRelationshipNamespacesRequest) Execute() (*RelationshipNamespaces, *http.Response, error) {
+	return r.ApiService.ListRelationshipNamespacesExecute(r)
+}
+
+/*
+ * ListRelationshipNamespaces Query namespaces
+ * Get all namespaces
+ * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ * @return RelationshipApiApiListRelationshipNamespacesRequest
+ */
+func (a *RelationshipApiService) ListRelationshipNamespaces(ctx context.Context) RelationshipApiApiListRelationshipNamespacesRequest {
+	return RelationshipApiApiListRelationshipNamespacesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return RelationshipNamespaces
+ */
+func (a *RelationshipApiService) ListRelationshipNamespacesExecute(r RelationshipApiApiListRelationshipNamespacesRequest) (*RelationshipNamespaces, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *RelationshipNamespaces
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.ListRelationshipNamespaces")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/namespaces"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiPatchRelationshipsRequest struct {
+	ctx               context.Context
+	ApiService        RelationshipApi
+	relationshipPatch *[]RelationshipPatch
+}
+
+func (r RelationshipApiApiPatchRelationshipsRequest) RelationshipPatch(relationshipPatch []RelationshipPatch) RelationshipApiApiPatchRelationshipsRequest {
+	r.relationshipPatch = &relationshipPatch
+	return r
+}
+
+func (r RelationshipApiApiPatchRelationshipsRequest) Execute() (*http.Response, error) {
+	return r.ApiService.PatchRelationshipsExecute(r)
+}
+
+/*
+  - PatchRelationships Patch Multiple Relationships
+  - Use this endpoint to patch one or more relationships.
+
+The body is either a list of changes, or a relationshipPatchWithPreconditions
+object with the changes and preconditions. The preconditions are checked in
+the transaction that applies the changes. If any of them does not hold, no
+change is applied and the endpoint responds with 409.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiPatchRelationshipsRequest
+*/
+func (a *RelationshipApiService) PatchRelationships(ctx context.Context) RelationshipApiApiPatchRelationshipsRequest {
+	return RelationshipApiApiPatchRelationshipsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ */
+func (a *RelationshipApiService) PatchRelationshipsExecute(r RelationshipApiApiPatchRelationshipsRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPatch
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.PatchRelationships")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/relation-tuples"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.relationshipPatch
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiRenameObjectRequest struct {
+	ctx              context.Context
+	ApiService       RelationshipApi
+	renameObjectBody *RenameObjectBody
+}
+
+func (r RelationshipApiApiRenameObjectRequest) RenameObjectBody(renameObjectBody RenameObjectBody) RelationshipApiApiRenameObjectRequest {
+	r.renameObjectBody = &renameObjectBody
+	return r
+}
+
+func (r RelationshipApiApiRenameObjectRequest) Execute() (*RenameObjectResult, *http.Response, error) {
+	return r.ApiService.RenameObjectExecute(r)
+}
+
+/*
+  - RenameObject Rename an Object
+  - Moves all relationships of the object, and all relationships with a subject
+
+set of the object, to the new object ID in one transaction. The new object
+must not have relationships yet.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiRenameObjectRequest
+*/
+func (a *RelationshipApiService) RenameObject(ctx context.Context) RelationshipApiApiRenameObjectRequest {
+	return RelationshipApiApiRenameObjectRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return RenameObjectResult
+ */
+func (a *RelationshipApiService) RenameObjectExecute(r RelationshipApiApiRenameObjectRequest) (*RenameObjectResult, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *RenameObjectResult
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.RenameObject")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/relation-tuples/objects/rename"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.renameObjectBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiRollbackOplSchemaRequest struct {
+	ctx                context.Context
+	ApiService         RelationshipApi
+	rollbackSchemaBody *RollbackSchemaBody
+}
+
+func (r RelationshipApiApiRollbackOplSchemaRequest) RollbackSchemaBody(rollbackSchemaBody RollbackSchemaBody) RelationshipApiApiRollbackOplSchemaRequest {
+	r.rollbackSchemaBody = &rollbackSchemaBody
+	return r
+}
+
+func (r RelationshipApiApiRollbackOplSchemaRequest) Execute() (*SchemaVersion, *http.Response, error) {
+	return r.ApiService.RollbackOplSchemaExecute(r)
 }
 
 /*
- * ListRelationshipNamespaces Query namespaces
- * Get all namespaces
- * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @return RelationshipApiApiListRelationshipNamespacesRequest
+  - RollbackOplSchema Roll back to an earlier OPL schema version
+  - Stores the content of the given version as a new version, which makes it
+
+the active one.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiRollbackOplSchemaRequest
+*/
+func (a *RelationshipApiService) RollbackOplSchema(ctx context.Context) RelationshipApiApiRollbackOplSchemaRequest {
+	return RelationshipApiApiRollbackOplSchemaRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return SchemaVersion
  */
-func (a *RelationshipApiService) ListRelationshipNamespaces(ctx context.Context) RelationshipApiApiListRelationshipNamespacesRequest {
-	return RelationshipApiApiListRelationshipNamespacesRequest{
+func (a *RelationshipApiService) RollbackOplSchemaExecute(r RelationshipApiApiRollbackOplSchemaRequest) (*SchemaVersion, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *SchemaVersion
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.RollbackOplSchema")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/namespaces/schema/rollback"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.rollbackSchemaBody
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type RelationshipApiApiWatchRelationshipsRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+	cursor     *string
+	namespace  *string
+	timeout    *string
+}
+
+func (r RelationshipApiApiWatchRelationshipsRequest) Cursor(cursor string) RelationshipApiApiWatchRelationshipsRequest {
+	r.cursor = &cursor
+	return r
+}
+func (r RelationshipApiApiWatchRelationshipsRequest) Namespace(namespace string) RelationshipApiApiWatchRelationshipsRequest {
+	r.namespace = &namespace
+	return r
+}
+func (r RelationshipApiApiWatchRelationshipsRequest) Timeout(timeout string) RelationshipApiApiWatchRelationshipsRequest {
+	r.timeout = &timeout
+	return r
+}
+
+func (r RelationshipApiApiWatchRelationshipsRequest) Execute() (*WatchRelationshipsResponse, *http.Response, error) {
+	return r.ApiService.WatchRelationshipsExecute(r)
+}
+
+/*
+  - WatchRelationships Watch relationship changes
+  - Returns the inserts and deletes of relationships that were committed after
+
+the cursor, in commit order. The request waits until there are changes or
+the timeout is reached (long polling).
+
+With the `Accept: text/event-stream` header, the changes are streamed as
+server sent events instead. Every event is one transaction and has the
+cursor as its ID, so that clients can resume with the `Last-Event-ID`
+header.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiWatchRelationshipsRequest
+*/
+func (a *RelationshipApiService) WatchRelationships(ctx context.Context) RelationshipApiApiWatchRelationshipsRequest {
+	return RelationshipApiApiWatchRelationshipsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -743,29 +2145,38 @@ func (a *RelationshipApiService) ListRelationshipNamespaces(ctx context.Context)
 
 /*
  * Execute executes the request
- * @return RelationshipNamespaces
+ * @return WatchRelationshipsResponse
  */
-func (a *RelationshipApiService) ListRelationshipNamespacesExecute(r RelationshipApiApiListRelationshipNamespacesRequest) (*RelationshipNamespaces, *http.Response, error) {
+func (a *RelationshipApiService) WatchRelationshipsExecute(r RelationshipApiApiWatchRelationshipsRequest) (*WatchRelationshipsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  *RelationshipNamespaces
+		localVarReturnValue  *WatchRelationshipsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.ListRelationshipNamespaces")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.WatchRelationships")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/namespaces"
+	localVarPath := localBasePath + "/relation-tuples/watch"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.cursor != nil {
+		localVarQueryParams.Add("cursor", parameterToString(*r.cursor, ""))
+	}
+	if r.namespace != nil {
+		localVarQueryParams.Add("namespace", parameterToString(*r.namespace, ""))
+	}
+	if r.timeout != nil {
+		localVarQueryParams.Add("timeout", parameterToString(*r.timeout, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -805,6 +2216,16 @@ func (a *RelationshipApiService) ListRelationshipNamespacesExecute(r Relationshi
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		var v ErrorGeneric
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -827,29 +2248,32 @@ func (a *RelationshipApiService) ListRelationshipNamespacesExecute(r Relationshi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type RelationshipApiApiPatchRelationshipsRequest struct {
-	ctx               context.Context
-	ApiService        RelationshipApi
-	relationshipPatch *[]RelationshipPatch
+type RelationshipApiApiWriteOplSchemaRequest struct {
+	ctx        context.Context
+	ApiService RelationshipApi
+	body       *string
 }
 
-func (r RelationshipApiApiPatchRelationshipsRequest) RelationshipPatch(relationshipPatch []RelationshipPatch) RelationshipApiApiPatchRelationshipsRequest {
-	r.relationshipPatch = &relationshipPatch
+func (r RelationshipApiApiWriteOplSchemaRequest) Body(body string) RelationshipApiApiWriteOplSchemaRequest {
+	r.body = &body
 	return r
 }
 
-func (r RelationshipApiApiPatchRelationshipsRequest) Execute() (*http.Response, error) {
-	return r.ApiService.PatchRelationshipsExecute(r)
+func (r RelationshipApiApiWriteOplSchemaRequest) Execute() (*WriteSchemaResult, *http.Response, error) {
+	return r.ApiService.WriteOplSchemaExecute(r)
 }
 
 /*
- * PatchRelationships Patch Multiple Relationships
- * Use this endpoint to patch one or more relationships.
- * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @return RelationshipApiApiPatchRelationshipsRequest
- */
-func (a *RelationshipApiService) PatchRelationships(ctx context.Context) RelationshipApiApiPatchRelationshipsRequest {
-	return RelationshipApiApiPatchRelationshipsRequest{
+  - WriteOplSchema Store a new OPL schema version
+  - The OPL file is expected in the body of the request. It is only stored if
+
+it can be parsed. The stored schema is used if the namespaces are configured
+to be loaded from the database.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return RelationshipApiApiWriteOplSchemaRequest
+*/
+func (a *RelationshipApiService) WriteOplSchema(ctx context.Context) RelationshipApiApiWriteOplSchemaRequest {
+	return RelationshipApiApiWriteOplSchemaRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -857,29 +2281,31 @@ func (a *RelationshipApiService) PatchRelationships(ctx context.Context) Relatio
 
 /*
  * Execute executes the request
+ * @return WriteSchemaResult
  */
-func (a *RelationshipApiService) PatchRelationshipsExecute(r RelationshipApiApiPatchRelationshipsRequest) (*http.Response, error) {
+func (a *RelationshipApiService) WriteOplSchemaExecute(r RelationshipApiApiWriteOplSchemaRequest) (*WriteSchemaResult, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodPatch
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
+		localVarReturnValue  *WriteSchemaResult
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.PatchRelationships")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RelationshipApiService.WriteOplSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/relation-tuples"
+	localVarPath := localBasePath + "/admin/namespaces/schema/versions"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{"text/plain"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -896,22 +2322,22 @@ func (a *RelationshipApiService) PatchRelationshipsExecute(r RelationshipApiApiP
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.relationshipPatch
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -920,34 +2346,43 @@ func (a *RelationshipApiService) PatchRelationshipsExecute(r RelationshipApiApiP
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorGeneric
+			var v WriteSchemaResult
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.model = v
-			return localVarHTTPResponse, newErr
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
+		if localVarHTTPResponse.StatusCode == 409 {
 			var v ErrorGeneric
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.model = v
-			return localVarHTTPResponse, newErr
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		var v ErrorGeneric
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.error = err.Error()
-			return localVarHTTPResponse, newErr
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		newErr.model = v
-		return localVarHTTPResponse, newErr
+		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarHTTPResponse, nil
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
@@ -19,6 +19,8 @@ import (
 type CheckPermissionResult struct {
 	// whether the relation tuple is allowed
 	Allowed bool `json:"allowed"`
+	// the hash of the OPL schema that was used for the check
+	SchemaHash *string `json:"schema_hash,omitempty"`
 }
 
 // NewCheckPermissionResult instantiates a new CheckPermissionResult object
@@ -63,11 +65,46 @@ func (o *CheckPermissionResult) SetAllowed(v bool) {
 	o.Allowed = v
 }
 
+// GetSchemaHash returns the SchemaHash field value if set, zero value otherwise.
+func (o *CheckPermissionResult) GetSchemaHash() string {
+	if o == nil || o.SchemaHash == nil {
+		var ret string
+		return ret
+	}
+	return *o.SchemaHash
+}
+
+// GetSchemaHashOk returns a tuple with the SchemaHash field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CheckPermissionResult) GetSchemaHashOk() (*string, bool) {
+	if o == nil || o.SchemaHash == nil {
+		return nil, false
+	}
+	return o.SchemaHash, true
+}
+
+// HasSchemaHash returns a boolean if a field has been set.
+func (o *CheckPermissionResult) HasSchemaHash() bool {
+	if o != nil && o.SchemaHash != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetSchemaHash gets a reference to the given string and assigns it to the SchemaHash field.
+func (o *CheckPermissionResult) SetSchemaHash(v string) {
+	o.SchemaHash = &v
+}
+
 func (o CheckPermissionResult) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
 		toSerialize["allowed"] = o.Allowed
 	}
+	if o.SchemaHash != nil {
+		toSerialize["schema_hash"] = o.SchemaHash
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -0,0 +1,54 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/herodot"
+)
+
+type (
+	// Record is the stored outcome of a request with an idempotency key.
+	Record struct {
+		RequestHash string
+		// Response is empty while the request is in progress.
+		Response string
+	}
+	Manager interface {
+		// ClaimIdempotencyKey stores an in-progress record of the request
+		// that expires at the end of the lease, unless the key has a record
+		// that expires after now, which it returns instead. Claims of
+		// requests that never complete are taken over once the lease ended.
+		ClaimIdempotencyKey(ctx context.Context, key, requestHash string, now, leaseExpiresAt time.Time) (*Record, error)
+		// CompleteIdempotencyKey stores the response of the claimed key until
+		// expiresAt.
+		CompleteIdempotencyKey(ctx context.Context, key, response string, expiresAt time.Time) error
+		// ReleaseIdempotencyKey deletes the record of the key, so that the
+		// request is executed again when it is retried.
+		ReleaseIdempotencyKey(ctx context.Context, key string) error
+	}
+	ManagerProvider interface {
+		IdempotencyManager() Manager
+	}
+)
+
+const (
+	// Header is the HTTP header, and gRPC metadata key, of the idempotency
+	// key.
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set on responses that are replayed from a stored
+	// outcome.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	// maxKeyLength is the length of the key column.
+	maxKeyLength = 255
+)
+
+var (
+	ErrKeyTooLong  = herodot.ErrBadRequest.WithErrorf("the idempotency key must not be longer than %d characters", maxKeyLength)
+	ErrKeyReused   = herodot.ErrBadRequest.WithError("the idempotency key was already used for a different request")
+	ErrKeyInFlight = herodot.ErrConflict.WithError("a request with the same idempotency key is in progress")
+)
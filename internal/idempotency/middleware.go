@@ -0,0 +1,270 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/x"
+)
+
+type (
+	dependencies interface {
+		config.Provider
+		ManagerProvider
+		x.LoggerProvider
+		x.WriterProvider
+	}
+	// httpResponse is the stored outcome of an HTTP request.
+	httpResponse struct {
+		Status int         `json:"status"`
+		Header http.Header `json:"header"`
+		Body   []byte      `json:"body"`
+	}
+	responseRecorder struct {
+		http.ResponseWriter
+		status int
+		body   bytes.Buffer
+	}
+)
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// HTTPMiddleware executes write requests with an idempotency key once, and
+// replays the stored response to retries with the same key.
+func HTTPMiddleware(d dependencies) func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	return func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		key := r.Header.Get(Header)
+		if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next(rw, r)
+			return
+		}
+		ctx := r.Context()
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			d.Writer().WriteError(rw, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		rec, err := claim(ctx, d, key, requestHash("http", r.Method, r.URL.Path, r.URL.RawQuery, string(body)))
+		if err != nil {
+			d.Writer().WriteError(rw, r, err)
+			return
+		}
+		if rec != nil {
+			var res httpResponse
+			if err := json.Unmarshal([]byte(rec.Response), &res); err != nil {
+				d.Writer().WriteError(rw, r, errors.WithStack(herodot.ErrInternalServerError.WithError(err.Error())))
+				return
+			}
+			for k, v := range res.Header {
+				rw.Header()[k] = v
+			}
+			rw.Header().Set(ReplayedHeader, "true")
+			rw.WriteHeader(res.Status)
+			_, _ = rw.Write(res.Body)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: rw, status: http.StatusOK}
+		next(recorder, r)
+
+		// The outcome is stored even if the client went away.
+		ctx = x.DetachedContext(ctx)
+		if recorder.status >= http.StatusInternalServerError {
+			release(ctx, d, key)
+			return
+		}
+		res, err := json.Marshal(&httpResponse{
+			Status: recorder.status,
+			Header: rw.Header().Clone(),
+			Body:   recorder.body.Bytes(),
+		})
+		if err != nil {
+			release(ctx, d, key)
+			return
+		}
+		complete(ctx, d, key, string(res))
+	}
+}
+
+// UnaryServerInterceptor executes calls of the given services with an
+// idempotency key once, and replays the stored response or error to retries
+// with the same key. Calls of other services are passed through.
+func UnaryServerInterceptor(d dependencies, services ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(Header)
+		msg, ok := req.(proto.Message)
+		if len(keys) == 0 || keys[0] == "" || !ok || !serviceOf(info.FullMethod, services) {
+			return handler(ctx, req)
+		}
+		key := keys[0]
+
+		raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, errors.WithStack(herodot.ErrInternalServerError.WithError(err.Error()))
+		}
+		rec, err := claim(ctx, d, key, requestHash("grpc", info.FullMethod, string(raw)))
+		if err != nil {
+			return nil, err
+		}
+		if rec != nil {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+			return replayGRPC(rec.Response)
+		}
+
+		resp, err := handler(ctx, req)
+
+		// The outcome is stored even if the client went away.
+		ctx = x.DetachedContext(ctx)
+		if outcome, ok := grpcOutcome(resp, err); ok {
+			complete(ctx, d, key, outcome)
+		} else {
+			release(ctx, d, key)
+		}
+		return resp, err
+	}
+}
+
+// claim returns the stored outcome of the request, or nil if the request was
+// claimed and has to be executed.
+func claim(ctx context.Context, d dependencies, key, hash string) (*Record, error) {
+	if len(key) > maxKeyLength {
+		return nil, errors.WithStack(ErrKeyTooLong)
+	}
+	now := time.Now().UTC()
+	rec, err := d.IdempotencyManager().ClaimIdempotencyKey(ctx, key, hash, now, now.Add(d.Config(ctx).IdempotencyLease()))
+	switch {
+	case err != nil:
+		return nil, err
+	case rec == nil:
+		return nil, nil
+	case rec.RequestHash != hash:
+		return nil, errors.WithStack(ErrKeyReused)
+	case rec.Response == "":
+		return nil, errors.WithStack(ErrKeyInFlight)
+	}
+	return rec, nil
+}
+
+func complete(ctx context.Context, d dependencies, key, response string) {
+	expiresAt := time.Now().UTC().Add(d.Config(ctx).IdempotencyWindow())
+	if err := d.IdempotencyManager().CompleteIdempotencyKey(ctx, key, response, expiresAt); err != nil {
+		d.Logger().WithError(err).WithField("idempotency_key", key).Error("could not store the outcome of the request")
+	}
+}
+
+func release(ctx context.Context, d dependencies, key string) {
+	if err := d.IdempotencyManager().ReleaseIdempotencyKey(ctx, key); err != nil {
+		d.Logger().WithError(err).WithField("idempotency_key", key).Error("could not release the idempotency key")
+	}
+}
+
+// grpcOutcome encodes the response or error of a call, unless the call has to
+// be executed again when it is retried.
+func grpcOutcome(resp interface{}, err error) (string, bool) {
+	st := grpcStatus(err)
+	if retryable(st.Code()) {
+		return "", false
+	}
+	var outcome proto.Message = st.Proto()
+	if err == nil {
+		m, ok := resp.(proto.Message)
+		if !ok {
+			return "", false
+		}
+		outcome = m
+	}
+	a, err := anypb.New(outcome)
+	if err != nil {
+		return "", false
+	}
+	encoded, err := protojson.Marshal(a)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
+
+func replayGRPC(response string) (interface{}, error) {
+	var a anypb.Any
+	if err := protojson.Unmarshal([]byte(response), &a); err != nil {
+		return nil, errors.WithStack(herodot.ErrInternalServerError.WithError(err.Error()))
+	}
+	m, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrInternalServerError.WithError(err.Error()))
+	}
+	if s, ok := m.(*spb.Status); ok {
+		return nil, status.ErrorProto(s)
+	}
+	return m, nil
+}
+
+// serviceOf returns whether the method belongs to one of the services.
+func serviceOf(fullMethod string, services []string) bool {
+	for _, s := range services {
+		if strings.HasPrefix(fullMethod, "/"+s+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// requestHash identifies the request, so that a key can not be reused for a
+// different request.
+func requestHash(parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+func grpcStatus(err error) *status.Status {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+	return status.Convert(err)
+}
+
+// retryable returns whether a call that failed with the code may not have been
+// executed, so that it is executed again when it is retried.
+func retryable(c codes.Code) bool {
+	switch c {
+	case codes.Unknown, codes.Canceled, codes.DeadlineExceeded, codes.Aborted,
+		codes.ResourceExhausted, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
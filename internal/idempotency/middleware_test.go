@@ -0,0 +1,194 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/idempotency"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "n"}}))
+
+	ts := httptest.NewServer(reg.WriteRouter(ctx))
+	t.Cleanup(ts.Close)
+
+	l := bufconn.Listen(1024 * 1024)
+	s := reg.WriteGRPCServer(ctx)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			t.Logf("Server exited with error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := rts.NewWriteServiceClient(conn)
+
+	owner := func(id string) *ketoapi.RelationTuple {
+		return &ketoapi.RelationTuple{Namespace: "n", Object: "doc", Relation: "owner", SubjectID: pointerx.Ptr(id)}
+	}
+	owners := func(t *testing.T) []*ketoapi.RelationTuple {
+		rs, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: pointerx.Ptr("n")})
+		require.NoError(t, err)
+		mapped, err := reg.Mapper().ToTuple(ctx, rs...)
+		require.NoError(t, err)
+		return mapped
+	}
+	patch := func(t *testing.T, key string, req *ketoapi.PatchRequest) *http.Response {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPatch, ts.URL+relationtuple.WriteRouteBase, bytes.NewReader(body))
+		require.NoError(t, err)
+		r.Header.Set(idempotency.Header, key)
+		resp, err := ts.Client().Do(r)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+	transfer := func(from, to string) *ketoapi.PatchRequest {
+		return &ketoapi.PatchRequest{
+			Deltas: []*ketoapi.PatchDelta{
+				{Action: ketoapi.ActionDelete, RelationTuple: owner(from)},
+				{Action: ketoapi.ActionInsert, RelationTuple: owner(to)},
+			},
+			Preconditions: []*ketoapi.Precondition{{TupleExists: owner(from)}},
+		}
+	}
+
+	relationtuple.MapAndWriteTuples(t, reg, owner("alice"))
+
+	t.Run("case=REST retries get the stored response", func(t *testing.T) {
+		resp := patch(t, "transfer-1", transfer("alice", "bob"))
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(idempotency.ReplayedHeader))
+
+		// executing the transfer again would violate its precondition
+		resp = patch(t, "transfer-1", transfer("alice", "bob"))
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "true", resp.Header.Get(idempotency.ReplayedHeader))
+		assert.Equal(t, []*ketoapi.RelationTuple{owner("bob")}, owners(t))
+
+		resp = patch(t, "transfer-2", transfer("alice", "bob"))
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		resp = patch(t, "transfer-2", transfer("alice", "bob"))
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "true", resp.Header.Get(idempotency.ReplayedHeader))
+		replayed, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, body, replayed)
+	})
+
+	t.Run("case=keys can not be reused for different requests", func(t *testing.T) {
+		resp := patch(t, "transfer-1", transfer("bob", "alice"))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, []*ketoapi.RelationTuple{owner("bob")}, owners(t))
+	})
+
+	t.Run("case=gRPC retries get the stored response or error", func(t *testing.T) {
+		req := &rts.TransactRelationTuplesRequest{
+			RelationTupleDeltas: []*rts.RelationTupleDelta{{
+				Action:        rts.RelationTupleDelta_ACTION_INSERT,
+				RelationTuple: owner("carol").ToProto(),
+			}},
+			Preconditions: []*rts.Precondition{(&ketoapi.Precondition{TupleExists: owner("alice")}).ToProto()},
+		}
+		transact := func(t *testing.T) (metadata.MD, error) {
+			var header metadata.MD
+			_, err := client.TransactRelationTuples(
+				metadata.AppendToOutgoingContext(ctx, idempotency.Header, "grpc-1"),
+				req, grpc.Header(&header))
+			return header, err
+		}
+
+		header, err := transact(t)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Empty(t, header.Get(idempotency.ReplayedHeader))
+
+		// the precondition holds now, but the retry gets the stored error
+		relationtuple.MapAndWriteTuples(t, reg, owner("alice"))
+		header, err = transact(t)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), relationtuple.ErrPreconditionFailed.ErrorField)
+		assert.Equal(t, []string{"true"}, header.Get(idempotency.ReplayedHeader))
+		assert.NotContains(t, owners(t), owner("carol"))
+	})
+
+	t.Run("case=keys expire after the window", func(t *testing.T) {
+		require.NoError(t, reg.Config(ctx).Set(config.KeyIdempotencyWindow, "1ms"))
+		t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyIdempotencyWindow, "24h")) })
+
+		resp := patch(t, "transfer-3", transfer("bob", "dave"))
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		time.Sleep(10 * time.Millisecond)
+
+		resp = patch(t, "transfer-3", transfer("bob", "dave"))
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(idempotency.ReplayedHeader))
+	})
+
+	t.Run("case=abandoned claims are taken over after the lease", func(t *testing.T) {
+		// a request that claimed the key, but never completed
+		now := time.Now().UTC()
+		rec, err := reg.IdempotencyManager().ClaimIdempotencyKey(ctx, "transfer-4", "crashed", now, now.Add(50*time.Millisecond))
+		require.NoError(t, err)
+		require.Nil(t, rec)
+
+		resp := patch(t, "transfer-4", transfer("dave", "erin"))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, owners(t), owner("dave"))
+
+		time.Sleep(100 * time.Millisecond)
+		resp = patch(t, "transfer-4", transfer("dave", "erin"))
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get(idempotency.ReplayedHeader))
+		assert.Contains(t, owners(t), owner("erin"))
+		assert.NotContains(t, owners(t), owner("dave"))
+	})
+
+	t.Run("case=reads on the write port do not claim keys", func(t *testing.T) {
+		_, err := rts.NewAuditServiceClient(conn).ListAuditEntries(
+			metadata.AppendToOutgoingContext(ctx, idempotency.Header, "read-1"),
+			&rts.ListAuditEntriesRequest{})
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		rec, err := reg.IdempotencyManager().ClaimIdempotencyKey(ctx, "read-1", "other", now, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, rec)
+	})
+}
@@ -10,6 +10,17 @@ type (
 		Name              string             `json:"name"`
 		Types             []RelationType     `json:"types,omitempty"`
 		SubjectSetRewrite *SubjectSetRewrite `json:"rewrite,omitempty"`
+		// SubjectTypes are the subject types that a permit applies to. It is
+		// empty if the permit applies to all subjects.
+		SubjectTypes []RelationType `json:"subject_types,omitempty"`
+		// Description is the doc comment of the relation or permit.
+		Description string `json:"description,omitempty"`
+		// Deprecated is set by the @deprecated decorator. Writing relationships
+		// of a deprecated relation is still allowed, but emits a warning.
+		Deprecated bool `json:"deprecated,omitempty"`
+		// DeprecationReason is the optional argument of the @deprecated
+		// decorator, e.g. what to use instead.
+		DeprecationReason string `json:"deprecation_reason,omitempty"`
 	}
 
 	RelationType struct {
@@ -40,6 +51,14 @@ type (
 		ComputedSubjectSetRelation string `json:"computed_subject_set_relation"`
 	}
 
+	// SubjectSetTraversal traverses the relation, and evaluates the rewrite
+	// on every subject set that the object has the relation with. Traversals
+	// that only check a single relation are parsed as TupleToSubjectSet.
+	SubjectSetTraversal struct {
+		Relation string             `json:"relation"`
+		Rewrite  *SubjectSetRewrite `json:"rewrite"`
+	}
+
 	// InvertResult inverts the check result of the child.
 	InvertResult struct {
 		Child Child `json:"inverted"`
@@ -66,6 +85,9 @@ func (c *ComputedSubjectSet) AsRewrite() *SubjectSetRewrite {
 func (t *TupleToSubjectSet) AsRewrite() *SubjectSetRewrite {
 	return &SubjectSetRewrite{Children: []Child{t}}
 }
+func (t *SubjectSetTraversal) AsRewrite() *SubjectSetRewrite {
+	return &SubjectSetRewrite{Children: []Child{t}}
+}
 func (i *InvertResult) AsRewrite() *SubjectSetRewrite {
 	return &SubjectSetRewrite{Children: []Child{i}}
 }
@@ -6,6 +6,7 @@ package namespace
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/ory/keto/internal/namespace/ast"
 )
@@ -30,4 +31,21 @@ type (
 	ManagerProvider interface {
 		NamespaceManager() (Manager, error)
 	}
+
+	// SchemaVersion is a stored version of the OPL schema. Versions are
+	// immutable, the latest one is the active one.
+	SchemaVersion struct {
+		Version   int
+		Content   string
+		CreatedAt time.Time
+	}
+	SchemaVersionManager interface {
+		// AddSchemaVersion stores the content as the next version.
+		AddSchemaVersion(ctx context.Context, content string) (*SchemaVersion, error)
+		// GetSchemaVersion returns the given version, or the latest one if
+		// version is 0.
+		GetSchemaVersion(ctx context.Context, version int) (*SchemaVersion, error)
+		// ListSchemaVersions returns all versions, the latest one first.
+		ListSchemaVersions(ctx context.Context) ([]*SchemaVersion, error)
+	}
 )
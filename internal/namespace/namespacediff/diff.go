@@ -0,0 +1,252 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacediff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/ast"
+	"github.com/ory/keto/internal/relationtuple"
+)
+
+type (
+	ChangeType string
+	Kind       string
+
+	// Change describes a single difference between two schemas.
+	Change struct {
+		Type      ChangeType `json:"type"`
+		Kind      Kind       `json:"kind"`
+		Namespace string     `json:"namespace"`
+		// Relation is empty for changes of a whole namespace.
+		Relation string `json:"relation,omitempty"`
+		// OldTypes and NewTypes are the allowed subject types of the relation
+		// before and after the change.
+		OldTypes []ast.RelationType `json:"old_types,omitempty"`
+		NewTypes []ast.RelationType `json:"new_types,omitempty"`
+		// OrphanedTuples is the number of stored relationships that are no
+		// longer valid after the change.
+		OrphanedTuples int `json:"orphaned_tuples"`
+	}
+)
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeRemoved ChangeType = "removed"
+	// ChangeRetyped is used for relations that allow different subject types
+	// than before, and for relations that became permits or vice versa.
+	ChangeRetyped ChangeType = "retyped"
+	// ChangeRewritten is used for permits that have a different rewrite, or
+	// apply to different subject types.
+	ChangeRewritten ChangeType = "rewritten"
+
+	KindNamespace Kind = "namespace"
+	KindRelation  Kind = "relation"
+	KindPermit    Kind = "permit"
+)
+
+// Compute returns the changes that turn the old namespaces into the new ones.
+// The changes are sorted by namespace and relation.
+func Compute(old, new []*namespace.Namespace) []*Change {
+	oldByName, newByName := byName(old), byName(new)
+	var changes []*Change
+
+	for name, o := range oldByName {
+		n, ok := newByName[name]
+		if !ok {
+			changes = append(changes, &Change{Type: ChangeRemoved, Kind: KindNamespace, Namespace: name})
+			continue
+		}
+		changes = append(changes, compareRelations(name, o.Relations, n.Relations)...)
+	}
+	for name := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			changes = append(changes, &Change{Type: ChangeAdded, Kind: KindNamespace, Namespace: name})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Namespace != changes[j].Namespace {
+			return changes[i].Namespace < changes[j].Namespace
+		}
+		return changes[i].Relation < changes[j].Relation
+	})
+	return changes
+}
+
+func compareRelations(namespace string, old, new []ast.Relation) (changes []*Change) {
+	oldByName, newByName := relationsByName(old), relationsByName(new)
+
+	for name, o := range oldByName {
+		n, ok := newByName[name]
+		switch {
+		case !ok:
+			changes = append(changes, &Change{
+				Type:      ChangeRemoved,
+				Kind:      kindOf(o),
+				Namespace: namespace,
+				Relation:  name,
+				OldTypes:  o.Types,
+			})
+		case kindOf(o) != kindOf(n) || !sameTypes(o.Types, n.Types):
+			changes = append(changes, &Change{
+				Type:      ChangeRetyped,
+				Kind:      kindOf(n),
+				Namespace: namespace,
+				Relation:  name,
+				OldTypes:  o.Types,
+				NewTypes:  n.Types,
+			})
+		case !reflect.DeepEqual(o.SubjectSetRewrite, n.SubjectSetRewrite) || !sameTypes(o.SubjectTypes, n.SubjectTypes):
+			changes = append(changes, &Change{
+				Type:      ChangeRewritten,
+				Kind:      kindOf(n),
+				Namespace: namespace,
+				Relation:  name,
+			})
+		}
+	}
+	for name, n := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			changes = append(changes, &Change{
+				Type:      ChangeAdded,
+				Kind:      kindOf(n),
+				Namespace: namespace,
+				Relation:  name,
+				NewTypes:  n.Types,
+			})
+		}
+	}
+	return
+}
+
+// IsBreaking returns whether the change can invalidate stored relationships.
+// Only removals and retyped relations that no longer allow a previous subject
+// type are breaking.
+func (c *Change) IsBreaking() bool {
+	switch c.Type {
+	case ChangeRemoved:
+		return true
+	case ChangeRetyped:
+		if c.Kind == KindPermit {
+			return true
+		}
+		return !containsAll(c.NewTypes, c.OldTypes)
+	}
+	return false
+}
+
+// CountOrphans sets the number of orphaned relationships on every breaking
+// change, based on the given counts of stored relationships.
+func CountOrphans(changes []*Change, counts []*relationtuple.SubjectTypeCount) {
+	for _, c := range changes {
+		if !c.IsBreaking() {
+			continue
+		}
+		c.OrphanedTuples = 0
+		for _, count := range counts {
+			if c.orphans(count) {
+				c.OrphanedTuples += count.Count
+			}
+		}
+	}
+}
+
+// TotalOrphans returns the sum of orphaned relationships of all changes.
+func TotalOrphans(changes []*Change) (total int) {
+	for _, c := range changes {
+		total += c.OrphanedTuples
+	}
+	return
+}
+
+func (c *Change) orphans(count *relationtuple.SubjectTypeCount) bool {
+	if count.Namespace != c.Namespace {
+		return false
+	}
+	if c.Kind == KindNamespace {
+		return c.Type == ChangeRemoved
+	}
+	if count.Relation != c.Relation {
+		return false
+	}
+	switch c.Type {
+	case ChangeRemoved:
+		return true
+	case ChangeRetyped:
+		if c.Kind == KindPermit {
+			// Permits are computed, so any stored relationship is orphaned.
+			return true
+		}
+		if count.IsSubjectID() {
+			// Subject IDs are not typed, so we can't tell if they are allowed.
+			return false
+		}
+		for _, t := range c.NewTypes {
+			if t.Namespace == count.SubjectSetNamespace && t.Relation == count.SubjectSetRelation {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func kindOf(r ast.Relation) Kind {
+	if r.SubjectSetRewrite != nil {
+		return KindPermit
+	}
+	return KindRelation
+}
+
+func byName(nn []*namespace.Namespace) map[string]*namespace.Namespace {
+	res := make(map[string]*namespace.Namespace, len(nn))
+	for _, n := range nn {
+		res[n.Name] = n
+	}
+	return res
+}
+
+func relationsByName(rr []ast.Relation) map[string]ast.Relation {
+	res := make(map[string]ast.Relation, len(rr))
+	for _, r := range rr {
+		res[r.Name] = r
+	}
+	return res
+}
+
+func sameTypes(a, b []ast.RelationType) bool {
+	return containsAll(a, b) && containsAll(b, a)
+}
+
+// containsAll returns whether every type of sub is also in types.
+func containsAll(types, sub []ast.RelationType) bool {
+	set := make(map[ast.RelationType]struct{}, len(types))
+	for _, t := range types {
+		set[t] = struct{}{}
+	}
+	for _, t := range sub {
+		if _, ok := set[t]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatTypes formats the relation types like they are written in OPL.
+func FormatTypes(types []ast.RelationType) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		if t.Relation == "" {
+			s[i] = t.Namespace
+		} else {
+			s[i] = fmt.Sprintf("SubjectSet<%s, %q>", t.Namespace, t.Relation)
+		}
+	}
+	return strings.Join(s, " | ")
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacediff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/namespacediff"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/schema"
+)
+
+func parse(t *testing.T, src string) []*namespace.Namespace {
+	nn, errs := schema.Parse(src)
+	require.Len(t, errs, 0)
+	res := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		res[i] = &nn[i]
+	}
+	return res
+}
+
+const oldSchema = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Folder implements Namespace {}
+class Document implements Namespace {
+  related: {
+    owners: (User | SubjectSet<Group, "members">)[]
+    viewers: User[]
+    parents: Folder[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`
+
+const newSchema = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Team implements Namespace {}
+class Document implements Namespace {
+  related: {
+    owners: User[]
+    viewers: (User | Team)[]
+    editors: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject) || this.related.owners.includes(ctx.subject),
+  }
+}
+`
+
+func TestCompute(t *testing.T) {
+	changes := namespacediff.Compute(parse(t, oldSchema), parse(t, newSchema))
+
+	type summary struct {
+		Type      namespacediff.ChangeType
+		Kind      namespacediff.Kind
+		Namespace string
+		Relation  string
+		Breaking  bool
+	}
+	actual := make([]summary, len(changes))
+	for i, c := range changes {
+		actual[i] = summary{c.Type, c.Kind, c.Namespace, c.Relation, c.IsBreaking()}
+	}
+
+	assert.Equal(t, []summary{
+		{namespacediff.ChangeAdded, namespacediff.KindRelation, "Document", "editors", false},
+		{namespacediff.ChangeRetyped, namespacediff.KindRelation, "Document", "owners", true},
+		{namespacediff.ChangeRemoved, namespacediff.KindRelation, "Document", "parents", true},
+		{namespacediff.ChangeRewritten, namespacediff.KindPermit, "Document", "view", false},
+		{namespacediff.ChangeRetyped, namespacediff.KindRelation, "Document", "viewers", false},
+		{namespacediff.ChangeRemoved, namespacediff.KindNamespace, "Folder", "", true},
+		{namespacediff.ChangeAdded, namespacediff.KindNamespace, "Team", "", false},
+	}, actual)
+
+	t.Run("case=no changes", func(t *testing.T) {
+		assert.Empty(t, namespacediff.Compute(parse(t, oldSchema), parse(t, oldSchema)))
+	})
+
+	t.Run("case=changed subject types", func(t *testing.T) {
+		restricted := strings.Replace(oldSchema, "view: (ctx: Context)", "view: (ctx: Context<User>)", 1)
+		changes := namespacediff.Compute(parse(t, oldSchema), parse(t, restricted))
+		require.Len(t, changes, 1)
+		assert.Equal(t, namespacediff.ChangeRewritten, changes[0].Type)
+		assert.Equal(t, "view", changes[0].Relation)
+	})
+}
+
+func TestCountOrphans(t *testing.T) {
+	changes := namespacediff.Compute(parse(t, oldSchema), parse(t, newSchema))
+	namespacediff.CountOrphans(changes, []*relationtuple.SubjectTypeCount{
+		// still valid
+		{Namespace: "Document", Relation: "owners", Count: 5},
+		{Namespace: "Document", Relation: "viewers", Count: 3},
+		{Namespace: "Group", Relation: "members", Count: 7},
+		// no longer allowed subject set
+		{Namespace: "Document", Relation: "owners", SubjectSetNamespace: "Group", SubjectSetRelation: "members", Count: 2},
+		// removed relation
+		{Namespace: "Document", Relation: "parents", SubjectSetNamespace: "Folder", Count: 4},
+		// removed namespace
+		{Namespace: "Folder", Relation: "anything", Count: 1},
+		{Namespace: "Folder", Relation: "other", Count: 1},
+	})
+
+	orphans := map[string]int{}
+	for _, c := range changes {
+		orphans[c.Namespace+"#"+c.Relation] = c.OrphanedTuples
+	}
+	assert.Equal(t, map[string]int{
+		"Document#editors": 0,
+		"Document#owners":  2,
+		"Document#parents": 4,
+		"Document#view":    0,
+		"Document#viewers": 0,
+		"Folder#":          2,
+		"Team#":            0,
+	}, orphans)
+	assert.Equal(t, 8, namespacediff.TotalOrphans(changes))
+}
+
+func TestFormatTypes(t *testing.T) {
+	nn := parse(t, oldSchema)
+	for _, n := range nn {
+		if n.Name != "Document" {
+			continue
+		}
+		for _, r := range n.Relations {
+			if r.Name == "owners" {
+				assert.Equal(t, `User | SubjectSet<Group, "members">`, namespacediff.FormatTypes(r.Types))
+				return
+			}
+		}
+	}
+	t.Fatal("relation Document#owners not found")
+}
@@ -12,7 +12,12 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/ast"
+	"github.com/ory/keto/internal/persistence"
 	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
 
@@ -21,6 +26,7 @@ type (
 		x.LoggerProvider
 		x.WriterProvider
 		config.Provider
+		persistence.Provider
 	}
 	handler struct {
 		handlerDeps
@@ -28,7 +34,8 @@ type (
 )
 
 const (
-	RouteBase = "/namespaces"
+	RouteBase   = "/namespaces"
+	SchemaRoute = RouteBase + "/schema"
 )
 
 func New(d handlerDeps) *handler {
@@ -37,14 +44,22 @@ func New(d handlerDeps) *handler {
 
 func (h *handler) RegisterReadRoutes(r *x.ReadRouter) {
 	r.GET(RouteBase, h.getNamespaces)
+	r.GET(SchemaRoute, h.getNamespaceSchemas)
 }
 
 func (h *handler) RegisterReadGRPC(s *grpc.Server) {
 	rts.RegisterNamespacesServiceServer(s, h)
 }
 
-func (h *handler) RegisterWriteRoutes(r *x.WriteRouter) {}
-func (h *handler) RegisterWriteGRPC(s *grpc.Server)     {}
+func (h *handler) RegisterWriteRoutes(r *x.WriteRouter) {
+	r.POST(SchemaVersionsRoute, h.postSchemaVersion)
+	r.GET(SchemaVersionsRoute, h.getSchemaVersions)
+	r.POST(SchemaRollbackRoute, h.postSchemaRollback)
+}
+
+func (h *handler) RegisterWriteGRPC(s *grpc.Server) {
+	opl.RegisterSchemaServiceServer(s, h)
+}
 
 func (h *handler) ListNamespaces(ctx context.Context, _ *rts.ListNamespacesRequest) (*rts.ListNamespacesResponse, error) {
 	m, err := h.Config(ctx).NamespaceManager()
@@ -86,3 +101,149 @@ func (h *handler) getNamespaces(w http.ResponseWriter, r *http.Request, _ httpro
 	}
 	h.Writer().Write(w, r, res)
 }
+
+func (h *handler) DescribeNamespaces(ctx context.Context, req *rts.DescribeNamespacesRequest) (*rts.DescribeNamespacesResponse, error) {
+	res, err := h.describeNamespaces(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return res.ToProto(), nil
+}
+
+func (h *handler) describeNamespaces(ctx context.Context, name string) (*ketoapi.DescribeNamespacesResponse, error) {
+	m, err := h.Config(ctx).NamespaceManager()
+	if err != nil {
+		h.Logger().WithError(err).Errorf("could not get namespace manager")
+		return nil, herodot.ErrInternalServerError
+	}
+
+	var namespaces []*namespace.Namespace
+	if name != "" {
+		n, err := m.GetNamespaceByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = []*namespace.Namespace{n}
+	} else {
+		namespaces, err = m.Namespaces(ctx)
+		if err != nil {
+			h.Logger().WithError(err).Errorf("could not get namespaces")
+			return nil, herodot.ErrInternalServerError
+		}
+	}
+
+	res := &ketoapi.DescribeNamespacesResponse{
+		Namespaces: make([]*ketoapi.NamespaceSchema, len(namespaces)),
+	}
+	for i, n := range namespaces {
+		res.Namespaces[i] = toNamespaceSchema(n)
+	}
+	return res, nil
+}
+
+// Describe Namespaces Request Parameters
+//
+// swagger:parameters describeNamespaces
+type describeNamespaces struct {
+	// Only describe the namespace with this name.
+	//
+	// in: query
+	Namespace string `json:"namespace"`
+}
+
+// swagger:route GET /namespaces/schema relationship describeNamespaces
+//
+// # Describe namespaces
+//
+// Get the relations, allowed subject types, and permit rewrites of all namespaces
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: namespaceSchemas
+//	  404: errorGeneric
+//	  default: errorGeneric
+func (h *handler) getNamespaceSchemas(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	res, err := h.describeNamespaces(r.Context(), r.URL.Query().Get("namespace"))
+	if err != nil {
+		h.Writer().WriteError(w, r, err)
+		return
+	}
+	h.Writer().Write(w, r, res)
+}
+
+func toNamespaceSchema(n *namespace.Namespace) *ketoapi.NamespaceSchema {
+	res := &ketoapi.NamespaceSchema{
+		Name:      n.Name,
+		Relations: make([]*ketoapi.NamespaceRelation, len(n.Relations)),
+	}
+	for i, r := range n.Relations {
+		rel := &ketoapi.NamespaceRelation{
+			Name:              r.Name,
+			Types:             make([]*ketoapi.RelationType, len(r.Types)),
+			Description:       r.Description,
+			Deprecated:        r.Deprecated,
+			DeprecationReason: r.DeprecationReason,
+		}
+		for j, t := range r.Types {
+			rel.Types[j] = &ketoapi.RelationType{
+				Namespace: t.Namespace,
+				Relation:  t.Relation,
+			}
+		}
+		for _, t := range r.SubjectTypes {
+			rel.SubjectTypes = append(rel.SubjectTypes, &ketoapi.RelationType{
+				Namespace: t.Namespace,
+				Relation:  t.Relation,
+			})
+		}
+		if r.SubjectSetRewrite != nil {
+			rel.Rewrite = toRewriteNode(r.SubjectSetRewrite)
+		}
+		res.Relations[i] = rel
+	}
+	return res
+}
+
+func toRewriteNode(child ast.Child) *ketoapi.RewriteNode {
+	switch c := child.(type) {
+	case *ast.SubjectSetRewrite:
+		n := &ketoapi.RewriteNode{
+			Type:     ketoapi.TreeNodeUnion,
+			Children: make([]*ketoapi.RewriteNode, len(c.Children)),
+		}
+		if c.Operation == ast.OperatorAnd {
+			n.Type = ketoapi.TreeNodeIntersection
+		}
+		for i, cc := range c.Children {
+			n.Children[i] = toRewriteNode(cc)
+		}
+		return n
+	case *ast.ComputedSubjectSet:
+		return &ketoapi.RewriteNode{
+			Type:     ketoapi.TreeNodeComputedSubjectSet,
+			Relation: c.Relation,
+		}
+	case *ast.TupleToSubjectSet:
+		return &ketoapi.RewriteNode{
+			Type:                       ketoapi.TreeNodeTupleToSubjectSet,
+			Relation:                   c.Relation,
+			ComputedSubjectSetRelation: c.ComputedSubjectSetRelation,
+		}
+	case *ast.SubjectSetTraversal:
+		return &ketoapi.RewriteNode{
+			Type:     ketoapi.TreeNodeTupleToSubjectSet,
+			Relation: c.Relation,
+			Children: []*ketoapi.RewriteNode{toRewriteNode(c.Rewrite)},
+		}
+	case *ast.InvertResult:
+		return &ketoapi.RewriteNode{
+			Type:     ketoapi.TreeNodeNot,
+			Children: []*ketoapi.RewriteNode{toRewriteNode(c.Child)},
+		}
+	}
+	return &ketoapi.RewriteNode{Type: ketoapi.TreeNodeUnspecified}
+}
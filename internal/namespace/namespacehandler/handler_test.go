@@ -0,0 +1,189 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacehandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/namespacehandler"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const namespaces = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+    parents: Document[]
+    /** Users that can't view the document. */
+    @deprecated("use a permit")
+    banned: User[]
+  }
+  permits = {
+    view: (ctx: Context) =>
+      (this.related.viewers.includes(ctx.subject) ||
+        this.related.parents.traverse((p) => p.permits.view(ctx))) &&
+      !this.related.banned.includes(ctx.subject),
+    edit: (ctx: Context<User>) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`
+
+func TestDescribeNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	nn, errs := schema.Parse(namespaces)
+	require.Len(t, errs, 0)
+	nspaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		nspaces[i] = &nn[i]
+	}
+
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces(nspaces))
+	h := namespacehandler.New(reg)
+
+	r := &x.ReadRouter{Router: httprouter.New()}
+	h.RegisterReadRoutes(r)
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	t.Run("proto=REST", func(t *testing.T) {
+		t.Run("case=all namespaces", func(t *testing.T) {
+			resp, err := ts.Client().Get(ts.URL + namespacehandler.SchemaRoute)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var res ketoapi.DescribeNamespacesResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			require.Len(t, res.Namespaces, 3)
+		})
+
+		t.Run("case=one namespace", func(t *testing.T) {
+			resp, err := ts.Client().Get(ts.URL + namespacehandler.SchemaRoute + "?namespace=Document")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var res ketoapi.DescribeNamespacesResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			require.Len(t, res.Namespaces, 1)
+
+			doc := res.Namespaces[0]
+			assert.Equal(t, "Document", doc.Name)
+			require.Len(t, doc.Relations, 5)
+			assert.Equal(t, &ketoapi.NamespaceRelation{
+				Name: "viewers",
+				Types: []*ketoapi.RelationType{
+					{Namespace: "User"},
+					{Namespace: "Group", Relation: "members"},
+				},
+			}, doc.Relations[0])
+
+			assert.Equal(t, &ketoapi.NamespaceRelation{
+				Name:              "banned",
+				Types:             []*ketoapi.RelationType{{Namespace: "User"}},
+				Description:       "Users that can't view the document.",
+				Deprecated:        true,
+				DeprecationReason: "use a permit",
+			}, doc.Relations[2])
+
+			view := doc.Relations[3]
+			assert.Equal(t, "view", view.Name)
+			assert.Empty(t, view.Types)
+			assert.Empty(t, view.SubjectTypes)
+			assert.Equal(t, &ketoapi.RewriteNode{
+				Type: ketoapi.TreeNodeIntersection,
+				Children: []*ketoapi.RewriteNode{{
+					Type: ketoapi.TreeNodeUnion,
+					Children: []*ketoapi.RewriteNode{
+						{
+							// the parser wraps the parenthesized expression
+							Type:     ketoapi.TreeNodeUnion,
+							Children: []*ketoapi.RewriteNode{{Type: ketoapi.TreeNodeComputedSubjectSet, Relation: "viewers"}},
+						},
+						{Type: ketoapi.TreeNodeTupleToSubjectSet, Relation: "parents", ComputedSubjectSetRelation: "view"},
+					},
+				}, {
+					Type:     ketoapi.TreeNodeNot,
+					Children: []*ketoapi.RewriteNode{{Type: ketoapi.TreeNodeComputedSubjectSet, Relation: "banned"}},
+				}},
+			}, view.Rewrite)
+
+			edit := doc.Relations[4]
+			assert.Equal(t, "edit", edit.Name)
+			assert.Equal(t, []*ketoapi.RelationType{{Namespace: "User"}}, edit.SubjectTypes)
+		})
+
+		t.Run("case=unknown namespace", func(t *testing.T) {
+			resp, err := ts.Client().Get(ts.URL + namespacehandler.SchemaRoute + "?namespace=Unknown")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		})
+	})
+
+	t.Run("proto=gRPC", func(t *testing.T) {
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer()
+		h.RegisterReadGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+
+		client := rts.NewNamespacesServiceClient(conn)
+
+		res, err := client.DescribeNamespaces(ctx, &rts.DescribeNamespacesRequest{Namespace: "Document"})
+		require.NoError(t, err)
+		require.Len(t, res.Namespaces, 1)
+		require.Len(t, res.Namespaces[0].Relations, 5)
+
+		viewers := res.Namespaces[0].Relations[0]
+		assert.Equal(t, "viewers", viewers.Name)
+		require.Len(t, viewers.Types, 2)
+		assert.Equal(t, "members", viewers.Types[1].Relation)
+
+		banned := res.Namespaces[0].Relations[2]
+		assert.Equal(t, "Users that can't view the document.", banned.Description)
+		assert.True(t, banned.Deprecated)
+		assert.Equal(t, "use a permit", banned.DeprecationReason)
+
+		view := res.Namespaces[0].Relations[3]
+		assert.Equal(t, rts.RewriteNodeType_REWRITE_NODE_TYPE_INTERSECTION, view.Rewrite.Type)
+		assert.Equal(t, rts.RewriteNodeType_REWRITE_NODE_TYPE_NOT, view.Rewrite.Children[1].Type)
+		assert.Empty(t, view.SubjectTypes)
+
+		edit := res.Namespaces[0].Relations[4]
+		require.Len(t, edit.SubjectTypes, 1)
+		assert.Equal(t, "User", edit.SubjectTypes[0].Namespace)
+	})
+}
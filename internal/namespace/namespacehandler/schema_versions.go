@@ -0,0 +1,259 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacehandler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/ketoapi"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
+)
+
+const (
+	SchemaVersionsRoute = "/admin" + SchemaRoute + "/versions"
+	SchemaRollbackRoute = "/admin" + SchemaRoute + "/rollback"
+)
+
+func (h *handler) WriteSchema(ctx context.Context, req *opl.WriteSchemaRequest) (*opl.WriteSchemaResponse, error) {
+	res, err := h.writeSchema(ctx, string(req.GetContent()))
+	if err != nil {
+		return nil, err
+	}
+	protoRes := &opl.WriteSchemaResponse{ParseErrors: make([]*opl.ParseError, len(res.Errors))}
+	if res.Version != nil {
+		protoRes.Version = res.Version.ToProto()
+	}
+	for i, e := range res.Errors {
+		protoRes.ParseErrors[i] = e.ToProto()
+	}
+	return protoRes, nil
+}
+
+func (h *handler) ListSchemaVersions(ctx context.Context, _ *opl.ListSchemaVersionsRequest) (*opl.ListSchemaVersionsResponse, error) {
+	res, err := h.listSchemaVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoRes := &opl.ListSchemaVersionsResponse{Versions: make([]*opl.SchemaVersion, len(res.Versions))}
+	for i, v := range res.Versions {
+		protoRes.Versions[i] = v.ToProto()
+	}
+	return protoRes, nil
+}
+
+func (h *handler) RollbackSchema(ctx context.Context, req *opl.RollbackSchemaRequest) (*opl.RollbackSchemaResponse, error) {
+	v, err := h.rollbackSchema(ctx, int(req.GetVersion()))
+	if err != nil {
+		return nil, err
+	}
+	return &opl.RollbackSchemaResponse{Version: v.ToProto()}, nil
+}
+
+// writeSchema stores the content as a new schema version, if it can be parsed
+// and the schema change guard accepts it. The namespaces of this instance are
+// updated right away, all others pick the new version up on their next poll.
+func (h *handler) writeSchema(ctx context.Context, content string) (*ketoapi.WriteSchemaResponse, error) {
+	nn, parseErrors := schema.Parse(content)
+	if len(parseErrors) > 0 {
+		res := &ketoapi.WriteSchemaResponse{Errors: make([]*ketoapi.ParseError, len(parseErrors))}
+		for i, e := range parseErrors {
+			res.Errors[i] = e.ToAPI()
+		}
+		return res, nil
+	}
+	updated := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		updated[i] = &nn[i]
+	}
+
+	m, err := h.Config(ctx).NamespaceManager()
+	if err != nil {
+		h.Logger().WithError(err).Errorf("could not get namespace manager")
+		return nil, herodot.ErrInternalServerError
+	}
+	current, err := m.Namespaces(ctx)
+	if err != nil {
+		h.Logger().WithError(err).Errorf("could not get namespaces")
+		return nil, herodot.ErrInternalServerError
+	}
+	if err := h.Config(ctx).CheckSchemaChange(ctx, current, updated); err != nil {
+		return nil, errors.WithStack(herodot.ErrConflict.WithReason(err.Error()))
+	}
+
+	v, err := h.Persister().AddSchemaVersion(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.Config(ctx).RefreshNamespaces(ctx); err != nil {
+		h.Logger().WithError(err).Errorf("could not load the new schema version")
+	}
+
+	return &ketoapi.WriteSchemaResponse{Version: toSchemaVersion(v)}, nil
+}
+
+func (h *handler) listSchemaVersions(ctx context.Context) (*ketoapi.ListSchemaVersionsResponse, error) {
+	vv, err := h.Persister().ListSchemaVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &ketoapi.ListSchemaVersionsResponse{Versions: make([]*ketoapi.SchemaVersion, len(vv))}
+	for i, v := range vv {
+		res.Versions[i] = toSchemaVersion(v)
+		res.Versions[i].Content = ""
+	}
+	return res, nil
+}
+
+// rollbackSchema stores the content of the given version as a new version.
+// Versions are never changed, so that the history stays complete.
+func (h *handler) rollbackSchema(ctx context.Context, version int) (*ketoapi.SchemaVersion, error) {
+	if version < 1 {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReason("The version must be at least 1."))
+	}
+	v, err := h.Persister().GetSchemaVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.writeSchema(ctx, v.Content)
+	if err != nil {
+		return nil, err
+	}
+	if res.Version == nil {
+		// can only happen if the parser changed since the version was stored
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Schema version %d could not be parsed.", version))
+	}
+	return res.Version, nil
+}
+
+func toSchemaVersion(v *namespace.SchemaVersion) *ketoapi.SchemaVersion {
+	return &ketoapi.SchemaVersion{
+		Version:   v.Version,
+		Content:   v.Content,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+// swagger:parameters writeOplSchema
+type writeOplSchema struct {
+	// in: body
+	Body writeOplSchemaBody
+}
+
+// Ory Permission Language Document
+//
+// swagger:model writeOplSchemaBody
+type writeOplSchemaBody string
+
+// swagger:route POST /admin/namespaces/schema/versions relationship writeOplSchema
+//
+// # Store a new OPL schema version
+//
+// The OPL file is expected in the body of the request. It is only stored if
+// it can be parsed. The stored schema is used if the namespaces are configured
+// to be loaded from the database.
+//
+//	Consumes:
+//	- text/plain
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: writeSchemaResult
+//	  400: writeSchemaResult
+//	  409: errorGeneric
+//	  default: errorGeneric
+func (h *handler) postSchemaVersion(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+	res, err := h.writeSchema(r.Context(), string(data))
+	if err != nil {
+		h.Writer().WriteError(w, r, err)
+		return
+	}
+	if res.Version == nil {
+		h.Writer().WriteCode(w, r, http.StatusBadRequest, res)
+		return
+	}
+	h.Writer().WriteCode(w, r, http.StatusCreated, res)
+}
+
+// swagger:route GET /admin/namespaces/schema/versions relationship listOplSchemaVersions
+//
+// # List the stored OPL schema versions
+//
+// Lists all stored versions, the active (latest) one first. The content is
+// omitted.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: schemaVersions
+//	  default: errorGeneric
+func (h *handler) getSchemaVersions(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	res, err := h.listSchemaVersions(r.Context())
+	if err != nil {
+		h.Writer().WriteError(w, r, err)
+		return
+	}
+	h.Writer().Write(w, r, res)
+}
+
+// swagger:parameters rollbackOplSchema
+type rollbackOplSchema struct {
+	// in: body
+	Body ketoapi.RollbackSchemaRequest
+}
+
+// swagger:route POST /admin/namespaces/schema/rollback relationship rollbackOplSchema
+//
+// # Roll back to an earlier OPL schema version
+//
+// Stores the content of the given version as a new version, which makes it
+// the active one.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: schemaVersion
+//	  400: errorGeneric
+//	  404: errorGeneric
+//	  409: errorGeneric
+//	  default: errorGeneric
+func (h *handler) postSchemaRollback(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var req ketoapi.RollbackSchemaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+	v, err := h.rollbackSchema(r.Context(), req.Version)
+	if err != nil {
+		h.Writer().WriteError(w, r, err)
+		return
+	}
+	h.Writer().WriteCode(w, r, http.StatusCreated, v)
+}
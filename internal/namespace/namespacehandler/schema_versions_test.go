@@ -0,0 +1,203 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacehandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace/namespacehandler"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
+)
+
+func namespaceNames(t *testing.T, reg *driver.RegistryDefault) []string {
+	ctx := context.Background()
+	m, err := reg.Config(ctx).NamespaceManager()
+	require.NoError(t, err)
+	nn, err := m.Namespaces(ctx)
+	require.NoError(t, err)
+	names := make([]string, len(nn))
+	for i, n := range nn {
+		names[i] = n.Name
+	}
+	return names
+}
+
+func newDatabaseSourceRegistry(t *testing.T) *driver.RegistryDefault {
+	reg := driver.NewSqliteTestRegistry(t, false)
+	require.NoError(t, reg.Config(context.Background()).Set(config.KeyNamespaces, map[string]any{
+		"source": config.NamespacesSourceDatabase,
+	}))
+	return reg
+}
+
+func TestSchemaVersions(t *testing.T) {
+	t.Run("proto=REST", func(t *testing.T) {
+		reg := newDatabaseSourceRegistry(t)
+		h := namespacehandler.New(reg)
+
+		r := &x.WriteRouter{Router: httprouter.New()}
+		h.RegisterWriteRoutes(r)
+		ts := httptest.NewServer(r)
+		t.Cleanup(ts.Close)
+
+		assert.Empty(t, namespaceNames(t, reg))
+
+		write := func(t *testing.T, content string) (*http.Response, *ketoapi.WriteSchemaResponse) {
+			resp, err := ts.Client().Post(ts.URL+namespacehandler.SchemaVersionsRoute, "text/plain", strings.NewReader(content))
+			require.NoError(t, err)
+			var res ketoapi.WriteSchemaResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			return resp, &res
+		}
+
+		resp, res := write(t, namespaces)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, 1, res.Version.Version)
+		assert.ElementsMatch(t, []string{"User", "Group", "Document"}, namespaceNames(t, reg))
+
+		t.Run("case=parse errors are not stored", func(t *testing.T) {
+			resp, res := write(t, "class Broken implements Namespace {")
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			assert.Nil(t, res.Version)
+			assert.NotEmpty(t, res.Errors)
+		})
+
+		resp, res = write(t, "class User implements Namespace {}")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, 2, res.Version.Version)
+		assert.Equal(t, []string{"User"}, namespaceNames(t, reg))
+
+		t.Run("case=list versions", func(t *testing.T) {
+			resp, err := ts.Client().Get(ts.URL + namespacehandler.SchemaVersionsRoute)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var res ketoapi.ListSchemaVersionsResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			require.Len(t, res.Versions, 2)
+			assert.Equal(t, 2, res.Versions[0].Version)
+			assert.Empty(t, res.Versions[0].Content)
+		})
+
+		t.Run("case=rollback", func(t *testing.T) {
+			body, err := json.Marshal(&ketoapi.RollbackSchemaRequest{Version: 1})
+			require.NoError(t, err)
+			resp, err := ts.Client().Post(ts.URL+namespacehandler.SchemaRollbackRoute, "application/json", bytes.NewReader(body))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+			var v ketoapi.SchemaVersion
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&v))
+			assert.Equal(t, 3, v.Version)
+			assert.Equal(t, namespaces, v.Content)
+			assert.ElementsMatch(t, []string{"User", "Group", "Document"}, namespaceNames(t, reg))
+		})
+
+		t.Run("case=rollback to unknown version", func(t *testing.T) {
+			resp, err := ts.Client().Post(ts.URL+namespacehandler.SchemaRollbackRoute, "application/json", strings.NewReader(`{"version": 42}`))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		})
+	})
+
+	t.Run("proto=gRPC", func(t *testing.T) {
+		ctx := context.Background()
+		reg := newDatabaseSourceRegistry(t)
+		h := namespacehandler.New(reg)
+
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer(grpc.UnaryInterceptor(herodot.UnaryErrorUnwrapInterceptor))
+		h.RegisterWriteGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+		client := opl.NewSchemaServiceClient(conn)
+
+		res, err := client.WriteSchema(ctx, &opl.WriteSchemaRequest{Content: []byte("class Broken implements Namespace {")})
+		require.NoError(t, err)
+		assert.Nil(t, res.Version)
+		assert.NotEmpty(t, res.ParseErrors)
+
+		res, err = client.WriteSchema(ctx, &opl.WriteSchemaRequest{Content: []byte(namespaces)})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, res.Version.Version)
+		assert.Empty(t, res.ParseErrors)
+
+		res, err = client.WriteSchema(ctx, &opl.WriteSchemaRequest{Content: []byte("class User implements Namespace {}")})
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, res.Version.Version)
+
+		list, err := client.ListSchemaVersions(ctx, &opl.ListSchemaVersionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.Versions, 2)
+
+		rollback, err := client.RollbackSchema(ctx, &opl.RollbackSchemaRequest{Version: 1})
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, rollback.Version.Version)
+		assert.ElementsMatch(t, []string{"User", "Group", "Document"}, namespaceNames(t, reg))
+
+		_, err = client.RollbackSchema(ctx, &opl.RollbackSchemaRequest{Version: 42})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("case=orphaning changes are rejected", func(t *testing.T) {
+		ctx := context.Background()
+		reg := newDatabaseSourceRegistry(t)
+		require.NoError(t, reg.Config(ctx).Set(config.KeyNamespacesRejectOrphaningChanges, true))
+		h := namespacehandler.New(reg)
+
+		r := &x.WriteRouter{Router: httprouter.New()}
+		h.RegisterWriteRoutes(r)
+		ts := httptest.NewServer(r)
+		t.Cleanup(ts.Close)
+
+		resp, err := ts.Client().Post(ts.URL+namespacehandler.SchemaVersionsRoute, "text/plain", strings.NewReader(namespaces))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		require.NoError(t, reg.RelationTupleManager().WriteRelationTuples(ctx, &relationtuple.RelationTuple{
+			Namespace: "Group",
+			Object:    uuid.Must(uuid.NewV4()),
+			Relation:  "members",
+			Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+		}))
+
+		resp, err = ts.Client().Post(ts.URL+namespacehandler.SchemaVersionsRoute, "text/plain", strings.NewReader("class User implements Namespace {}"))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.ElementsMatch(t, []string{"User", "Group", "Document"}, namespaceNames(t, reg))
+	})
+}
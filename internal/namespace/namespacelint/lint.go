@@ -0,0 +1,134 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacelint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/ast"
+	"github.com/ory/keto/internal/namespace/namespacediff"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+)
+
+type (
+	// Validator checks relationships against the relations and subject types
+	// that are defined in the namespaces.
+	Validator struct {
+		relations map[string]map[string]ast.Relation
+	}
+
+	// Violation is a relationship that is not allowed by the namespaces.
+	Violation struct {
+		RelationTuple *ketoapi.RelationTuple `json:"relation_tuple"`
+		Reason        string                 `json:"reason"`
+	}
+
+	dependencies interface {
+		relationtuple.ManagerProvider
+		relationtuple.MapperProvider
+	}
+)
+
+func NewValidator(nn []*namespace.Namespace) *Validator {
+	v := &Validator{relations: make(map[string]map[string]ast.Relation, len(nn))}
+	for _, n := range nn {
+		rels := make(map[string]ast.Relation, len(n.Relations))
+		for _, r := range n.Relations {
+			rels[r.Name] = r
+		}
+		v.relations[n.Name] = rels
+	}
+	return v
+}
+
+// Validate returns why the namespaces do not allow a relationship, or an empty
+// string if they do. The subject set is nil for subject IDs. Subject IDs are not
+// typed, so they are allowed in every relation.
+func (v *Validator) Validate(namespace, relation string, subjectSet *ast.RelationType) string {
+	rels, ok := v.relations[namespace]
+	if !ok {
+		return fmt.Sprintf("namespace %q is not defined", namespace)
+	}
+	r, ok := rels[relation]
+	if !ok {
+		return fmt.Sprintf("relation %q is not defined in namespace %q", relation, namespace)
+	}
+	if r.SubjectSetRewrite != nil {
+		return fmt.Sprintf("%s#%s is a permission, which is computed and can't have relationships", namespace, relation)
+	}
+	if subjectSet == nil {
+		return ""
+	}
+	for _, t := range r.Types {
+		if t == *subjectSet {
+			return ""
+		}
+	}
+	return fmt.Sprintf("subject type %s is not allowed in %s#%s, expected %s",
+		namespacediff.FormatTypes([]ast.RelationType{*subjectSet}), namespace, relation, namespacediff.FormatTypes(r.Types))
+}
+
+// ValidateTuple is like Validate, for a relationship in the API format.
+func (v *Validator) ValidateTuple(t *ketoapi.RelationTuple) string {
+	var subjectSet *ast.RelationType
+	if t.SubjectSet != nil {
+		subjectSet = &ast.RelationType{Namespace: t.SubjectSet.Namespace, Relation: t.SubjectSet.Relation}
+	}
+	return v.Validate(t.Namespace, t.Relation, subjectSet)
+}
+
+func (v *Validator) validateStored(t *relationtuple.RelationTuple) string {
+	var subjectSet *ast.RelationType
+	if s, ok := t.Subject.(*relationtuple.SubjectSet); ok {
+		subjectSet = &ast.RelationType{Namespace: s.Namespace, Relation: s.Relation}
+	}
+	return v.Validate(t.Namespace, t.Relation, subjectSet)
+}
+
+// LintStored pages through all stored relationships and calls report for
+// every one that is not allowed by the namespaces. Only the violations are
+// mapped back to the API format. It returns the number of scanned
+// relationships.
+func LintStored(ctx context.Context, d dependencies, v *Validator, pageSize int, report func(*Violation) error) (scanned int, err error) {
+	var (
+		rts       []*relationtuple.RelationTuple
+		pageToken string
+	)
+	for {
+		rts, pageToken, err = d.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{},
+			x.WithToken(pageToken), x.WithSize(pageSize))
+		if err != nil {
+			return scanned, err
+		}
+		scanned += len(rts)
+
+		var invalid []*relationtuple.RelationTuple
+		var reasons []string
+		for _, rt := range rts {
+			if reason := v.validateStored(rt); reason != "" {
+				invalid = append(invalid, rt)
+				reasons = append(reasons, reason)
+			}
+		}
+		if len(invalid) > 0 {
+			mapped, err := d.Mapper().ToTuple(ctx, invalid...)
+			if err != nil {
+				return scanned, err
+			}
+			for i, rt := range mapped {
+				if err := report(&Violation{RelationTuple: rt, Reason: reasons[i]}); err != nil {
+					return scanned, err
+				}
+			}
+		}
+
+		if pageToken == "" {
+			return scanned, nil
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacelint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/namespacelint"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/ketoapi"
+)
+
+const namespaces = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    owners: (User | SubjectSet<Group, "members">)[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.owners.includes(ctx.subject),
+  }
+}
+`
+
+func parse(t *testing.T, src string) []*namespace.Namespace {
+	nn, errs := schema.Parse(src)
+	require.Len(t, errs, 0)
+	res := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		res[i] = &nn[i]
+	}
+	return res
+}
+
+func tuple(t *testing.T, s string) *ketoapi.RelationTuple {
+	rt, err := (&ketoapi.RelationTuple{}).FromString(s)
+	require.NoError(t, err)
+	return rt
+}
+
+func TestValidator(t *testing.T) {
+	v := namespacelint.NewValidator(parse(t, namespaces))
+
+	for _, tc := range []struct {
+		tuple, reason string
+	}{
+		{tuple: "Document:d#owners@u"},
+		{tuple: "Document:d#owners@User:u"},
+		{tuple: "Document:d#owners@Group:g#members"},
+		{tuple: "Group:g#members@User:u"},
+		{
+			tuple:  "Folder:f#owners@u",
+			reason: `namespace "Folder" is not defined`,
+		},
+		{
+			tuple:  "Document:d#editors@u",
+			reason: `relation "editors" is not defined in namespace "Document"`,
+		},
+		{
+			tuple:  "Document:d#view@u",
+			reason: "Document#view is a permission, which is computed and can't have relationships",
+		},
+		{
+			tuple:  "Document:d#owners@Group:g",
+			reason: `subject type Group is not allowed in Document#owners, expected User | SubjectSet<Group, "members">`,
+		},
+		{
+			tuple:  "Group:g#members@Group:g#members",
+			reason: `subject type SubjectSet<Group, "members"> is not allowed in Group#members, expected User`,
+		},
+	} {
+		t.Run("tuple="+tc.tuple, func(t *testing.T) {
+			assert.Equal(t, tc.reason, v.ValidateTuple(tuple(t, tc.tuple)))
+		})
+	}
+}
+
+func TestLintStored(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false)
+	require.NoError(t, reg.Config(ctx).Set(config.KeyNamespaces, []*namespace.Namespace{{Name: "User"}, {Name: "Group"}, {Name: "Document"}, {Name: "Folder"}}))
+
+	valid := []*ketoapi.RelationTuple{
+		tuple(t, "Document:d#owners@u"),
+		tuple(t, "Document:d#owners@Group:g#members"),
+		tuple(t, "Group:g#members@User:u"),
+	}
+	invalid := []*ketoapi.RelationTuple{
+		tuple(t, "Folder:f#owners@u"),
+		tuple(t, "Document:d#owners@Group:g"),
+	}
+	rts, err := reg.Mapper().FromTuple(ctx, append(valid, invalid...)...)
+	require.NoError(t, err)
+	require.NoError(t, reg.RelationTupleManager().WriteRelationTuples(ctx, rts...))
+
+	var violations []*namespacelint.Violation
+	scanned, err := namespacelint.LintStored(ctx, reg, namespacelint.NewValidator(parse(t, namespaces)), 2,
+		func(v *namespacelint.Violation) error {
+			violations = append(violations, v)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 5, scanned)
+
+	require.Len(t, violations, 2)
+	reported := []*ketoapi.RelationTuple{violations[0].RelationTuple, violations[1].RelationTuple}
+	assert.ElementsMatch(t, invalid, reported)
+	for _, v := range violations {
+		assert.NotEmpty(t, v.Reason)
+	}
+
+	t.Run("case=subject IDs are mapped back", func(t *testing.T) {
+		for _, v := range violations {
+			if v.RelationTuple.Namespace == "Folder" {
+				assert.Equal(t, pointerx.Ptr("u"), v.RelationTuple.SubjectID)
+			}
+		}
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+// Package opltest runs declarative tests against an OPL schema.
+//
+// A test file contains fixture relationships in the format used by
+// `keto relation-tuple parse`, and assertions that are relationships followed
+// by `is allowed` or `is denied`:
+//
+//	// fixtures
+//	Document:readme#viewers@User:bob
+//
+//	// assertions
+//	Document:readme#view@User:bob is allowed
+//	Document:readme#view@User:alice is denied
+//
+// Comments (lines starting with `//`) and blank lines are ignored.
+package opltest
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/check"
+	"github.com/ory/keto/internal/check/checkgroup"
+	"github.com/ory/keto/internal/expand"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+)
+
+type (
+	// Suite is a parsed test file.
+	Suite struct {
+		Name       string
+		Tuples     []*ketoapi.RelationTuple
+		Assertions []*Assertion
+	}
+
+	Assertion struct {
+		Tuple   *ketoapi.RelationTuple
+		Allowed bool
+		// Line is the line number in the test file, starting at 1.
+		Line int
+	}
+
+	Result struct {
+		*Assertion
+		Passed bool
+		// Tree explains the decision of a failed assertion. For unexpectedly
+		// allowed checks it is the check tree, for unexpectedly denied checks
+		// it is the expand tree of the checked object and relation.
+		Tree *ketoapi.Tree[*ketoapi.RelationTuple]
+		Err  error
+	}
+
+	Dependencies interface {
+		relationtuple.ManagerProvider
+		relationtuple.MapperProvider
+		check.EngineProvider
+		expand.EngineProvider
+	}
+)
+
+var assertionRegexp = regexp.MustCompile(`^(.+?)\s+is\s+(allowed|denied)$`)
+
+// Parse parses the content of a test file. The name is used in error messages.
+func Parse(name, content string) (*Suite, error) {
+	s := &Suite{Name: name}
+
+	for i, row := range strings.Split(content, "\n") {
+		row = strings.TrimSpace(row)
+		// ignore comments and empty lines
+		if row == "" || strings.HasPrefix(row, "//") {
+			continue
+		}
+
+		tuple, allowed, isAssertion := row, false, false
+		if m := assertionRegexp.FindStringSubmatch(row); m != nil {
+			tuple, allowed, isAssertion = m[1], m[2] == "allowed", true
+		}
+
+		rt, err := (&ketoapi.RelationTuple{}).FromString(tuple)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode %s:%d %q", name, i+1, row)
+		}
+
+		if isAssertion {
+			s.Assertions = append(s.Assertions, &Assertion{Tuple: rt, Allowed: allowed, Line: i + 1})
+		} else {
+			s.Tuples = append(s.Tuples, rt)
+		}
+	}
+
+	return s, nil
+}
+
+// Run writes the fixtures of the suite and evaluates all assertions. The
+// dependencies should be backed by an empty store, e.g. an in-memory registry.
+func Run(ctx context.Context, d Dependencies, s *Suite) ([]*Result, error) {
+	tuples, err := d.Mapper().FromTuple(ctx, s.Tuples...)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "could not map the fixtures of %s", s.Name)
+	}
+	if err := d.RelationTupleManager().WriteRelationTuples(ctx, tuples...); err != nil {
+		return nil, errors.WithMessagef(err, "could not write the fixtures of %s", s.Name)
+	}
+
+	results := make([]*Result, len(s.Assertions))
+	for i, a := range s.Assertions {
+		results[i] = runAssertion(ctx, d, a)
+	}
+	return results, nil
+}
+
+func runAssertion(ctx context.Context, d Dependencies, a *Assertion) *Result {
+	res := &Result{Assertion: a}
+
+	tuples, err := d.Mapper().FromTuple(ctx, a.Tuple)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	checkRes := d.PermissionEngine().CheckRelationTuple(ctx, tuples[0], 0)
+	if checkRes.Err != nil {
+		res.Err = checkRes.Err
+		return res
+	}
+	allowed := checkRes.Membership == checkgroup.IsMember
+	if res.Passed = allowed == a.Allowed; res.Passed {
+		return res
+	}
+
+	if allowed {
+		res.Tree, res.Err = d.Mapper().ToCheckTree(ctx, checkRes.Tree)
+		return res
+	}
+
+	tree, err := d.ExpandEngine().BuildTree(ctx, &relationtuple.SubjectSet{
+		Namespace: tuples[0].Namespace,
+		Object:    tuples[0].Object,
+		Relation:  tuples[0].Relation,
+	}, 0)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	if tree != nil {
+		res.Tree, res.Err = d.Mapper().ToTree(ctx, tree)
+	}
+	return res
+}
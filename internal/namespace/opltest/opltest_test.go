@@ -0,0 +1,138 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package opltest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/namespace/opltest"
+	"github.com/ory/keto/internal/schema"
+)
+
+const namespaces = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+    owners: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject) || this.related.owners.includes(ctx.subject),
+  }
+}
+`
+
+func TestParse(t *testing.T) {
+	t.Run("case=fixtures and assertions", func(t *testing.T) {
+		s, err := opltest.Parse("test", `
+// fixtures
+Document:readme#owners@bob
+
+// assertions
+Document:readme#view@bob is allowed
+  Document:readme#view@(Group:devs#members)   is   denied
+`)
+		require.NoError(t, err)
+
+		require.Len(t, s.Tuples, 1)
+		assert.Equal(t, "Document:readme#owners@bob", s.Tuples[0].String())
+
+		require.Len(t, s.Assertions, 2)
+		assert.Equal(t, "Document:readme#view@bob", s.Assertions[0].Tuple.String())
+		assert.True(t, s.Assertions[0].Allowed)
+		assert.Equal(t, 6, s.Assertions[0].Line)
+		assert.Equal(t, "Document:readme#view@Group:devs#members", s.Assertions[1].Tuple.String())
+		assert.False(t, s.Assertions[1].Allowed)
+		assert.Equal(t, 7, s.Assertions[1].Line)
+	})
+
+	t.Run("case=invalid row", func(t *testing.T) {
+		_, err := opltest.Parse("test", "Document:readme#owners@bob\nnot a relationship is allowed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "test:2")
+	})
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	nn, errs := schema.Parse(namespaces)
+	require.Len(t, errs, 0)
+	nspaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		nspaces[i] = &nn[i]
+	}
+
+	s, err := opltest.Parse("test", `
+Group:devs#members@alice
+Document:readme#viewers@Group:devs#members
+Document:readme#owners@bob
+
+Document:readme#view@alice is allowed
+Document:readme#view@eve is denied
+Document:readme#view@bob is denied
+Document:readme#view@eve is allowed
+`)
+	require.NoError(t, err)
+
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces(nspaces))
+	results, err := opltest.Run(ctx, reg, s)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+	assert.True(t, results[0].Passed)
+	assert.True(t, results[1].Passed)
+
+	assert.False(t, results[2].Passed)
+	require.NotNil(t, results[2].Tree)
+	assert.Contains(t, results[2].Tree.String(), "Document:readme#owners@bob")
+
+	assert.False(t, results[3].Passed)
+
+	t.Run("case=unknown namespace in fixtures", func(t *testing.T) {
+		s, err := opltest.Parse("test", "Unknown:readme#owners@bob")
+		require.NoError(t, err)
+		_, err = opltest.Run(ctx, driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces(nspaces)), s)
+		assert.Error(t, err)
+	})
+}
+
+func TestInMemoryRegistry(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := opltest.Parse("test", "Document:readme#owners@bob\nDocument:readme#owners@bob is allowed")
+	require.NoError(t, err)
+
+	newRegistry := func(t *testing.T) *driver.RegistryDefault {
+		reg, err := driver.NewInMemoryRegistry(ctx, []*namespace.Namespace{{Name: "Document"}}, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, reg.Close()) })
+		return reg
+	}
+
+	results, err := opltest.Run(ctx, newRegistry(t), s)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Passed)
+
+	// a second registry does not see the relationships of the first one
+	s.Tuples = nil
+	results, err = opltest.Run(ctx, newRegistry(t), s)
+	require.NoError(t, err)
+	assert.False(t, results[0].Passed)
+}
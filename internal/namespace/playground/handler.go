@@ -0,0 +1,232 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+// Package playground evaluates OPL schemas against relationships that are only
+// kept in memory, so that models can be tried out without deploying them.
+package playground
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/ory/keto/internal/check"
+	"github.com/ory/keto/internal/check/checkgroup"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/expand"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/schema"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
+)
+
+type (
+	handlerDependencies interface {
+		x.LoggerProvider
+		x.WriterProvider
+		config.Provider
+	}
+	Handler struct {
+		d handlerDependencies
+	}
+)
+
+const RouteBase = "/opl/playground"
+
+// The OPL port is usually reachable without authentication, so the work of a
+// single request is limited.
+const (
+	maxRelationTuples = 10000
+	maxEvaluations    = 1000
+)
+
+var _ opl.PlaygroundServiceServer = (*Handler)(nil)
+
+func NewHandler(d handlerDependencies) *Handler {
+	return &Handler{d: d}
+}
+
+func (h *Handler) RegisterSyntaxRoutes(r *x.OPLSyntaxRouter) {
+	r.POST(RouteBase, h.postEvaluate)
+}
+
+func (h *Handler) RegisterSyntaxGRPC(s *grpc.Server) {
+	opl.RegisterPlaygroundServiceServer(s, h)
+}
+
+func (h *Handler) Evaluate(ctx context.Context, req *opl.EvaluateRequest) (*opl.EvaluateResponse, error) {
+	r, err := (&ketoapi.EvaluateOPLRequest{}).FromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.evaluate(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return res.ToProto(), nil
+}
+
+// Evaluate OPL Request Parameters
+//
+// swagger:parameters evaluateOpl
+type evaluateOpl struct {
+	// in: body
+	Body ketoapi.EvaluateOPLRequest
+}
+
+// swagger:route POST /opl/playground relationship evaluateOpl
+//
+// # Evaluate an OPL file
+//
+// Writes the relationships to a new, empty in-memory store that uses the OPL
+// file as its namespaces, and evaluates the checks and expands against it. The
+// database is not touched.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: evaluateOplResult
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) postEvaluate(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var req ketoapi.EvaluateOPLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+
+	res, err := h.evaluate(r.Context(), &req)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	h.d.Writer().Write(w, r, res)
+}
+
+func (h *Handler) evaluate(ctx context.Context, req *ketoapi.EvaluateOPLRequest) (*ketoapi.EvaluateOPLResponse, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	nn, parseErrors := schema.Parse(req.Schema)
+	if len(parseErrors) > 0 {
+		res := &ketoapi.EvaluateOPLResponse{Errors: make([]*ketoapi.ParseError, len(parseErrors))}
+		for i, e := range parseErrors {
+			res.Errors[i] = e.ToAPI()
+		}
+		return res, nil
+	}
+	namespaces := make([]*namespace.Namespace, len(nn))
+	for i := range nn {
+		namespaces[i] = &nn[i]
+	}
+
+	reg := newRegistry(h.d.Config(ctx), h.d.Logger(), namespaces)
+	tuples, err := reg.Mapper().FromTuple(ctx, req.RelationTuples...)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Could not map the relationships: %s", errorMessage(err)))
+	}
+	reg.writeRelationTuples(tuples)
+	permissionEngine, expandEngine := check.NewEngine(reg), expand.NewEngine(reg)
+
+	res := &ketoapi.EvaluateOPLResponse{
+		Checks:  make([]*ketoapi.PlaygroundCheckResult, len(req.Checks)),
+		Expands: make([]*ketoapi.PlaygroundExpandResult, len(req.Expands)),
+	}
+	for i, c := range req.Checks {
+		res.Checks[i] = evaluateCheck(ctx, reg, permissionEngine, c, req.MaxDepth)
+	}
+	for i, e := range req.Expands {
+		res.Expands[i] = evaluateExpand(ctx, reg, expandEngine, e, req.MaxDepth)
+	}
+	return res, nil
+}
+
+func validate(req *ketoapi.EvaluateOPLRequest) error {
+	if len(req.RelationTuples) > maxRelationTuples {
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("At most %d relationships can be evaluated at once.", maxRelationTuples))
+	}
+	if len(req.Checks)+len(req.Expands) > maxEvaluations {
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("At most %d checks and expands can be evaluated at once.", maxEvaluations))
+	}
+	for _, tuples := range [][]*ketoapi.RelationTuple{req.RelationTuples, req.Checks} {
+		for _, t := range tuples {
+			if t == nil {
+				return errors.WithStack(herodot.ErrBadRequest.WithReason("Relationships must not be null."))
+			}
+			if err := t.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	for _, s := range req.Expands {
+		if s == nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Subject sets must not be null."))
+		}
+	}
+	return nil
+}
+
+func evaluateCheck(ctx context.Context, reg *registry, e *check.Engine, t *ketoapi.RelationTuple, maxDepth int) *ketoapi.PlaygroundCheckResult {
+	res := &ketoapi.PlaygroundCheckResult{RelationTuple: t}
+
+	tuples, err := reg.Mapper().FromTuple(ctx, t)
+	if err != nil {
+		res.Error = errorMessage(err)
+		return res
+	}
+	checkRes := e.CheckRelationTuple(ctx, tuples[0], maxDepth)
+	if checkRes.Err != nil {
+		res.Error = errorMessage(checkRes.Err)
+		return res
+	}
+	res.Allowed = checkRes.Membership == checkgroup.IsMember
+	if res.Tree, err = reg.Mapper().ToCheckTree(ctx, checkRes.Tree); err != nil {
+		res.Error = fmt.Sprintf("could not map the check tree: %v", err)
+	}
+	return res
+}
+
+func evaluateExpand(ctx context.Context, reg *registry, e *expand.Engine, s *ketoapi.SubjectSet, maxDepth int) *ketoapi.PlaygroundExpandResult {
+	res := &ketoapi.PlaygroundExpandResult{SubjectSet: s}
+
+	set, err := reg.Mapper().FromSubjectSet(ctx, s)
+	if err != nil {
+		res.Error = errorMessage(err)
+		return res
+	}
+	tree, err := e.BuildTree(ctx, set, maxDepth)
+	if err != nil {
+		res.Error = errorMessage(err)
+		return res
+	}
+	if tree != nil {
+		if res.Tree, err = reg.Mapper().ToTree(ctx, tree); err != nil {
+			res.Error = fmt.Sprintf("could not map the expand tree: %v", err)
+		}
+	}
+	return res
+}
+
+// errorMessage returns the reason of herodot errors, as it is more specific
+// than their message.
+func errorMessage(err error) string {
+	var reasoner interface{ Reason() string }
+	if errors.As(err, &reasoner) && reasoner.Reason() != "" {
+		return reasoner.Reason()
+	}
+	return err.Error()
+}
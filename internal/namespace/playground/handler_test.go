@@ -0,0 +1,215 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package playground_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace/playground"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const namespaces = `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`
+
+func tuple(t *testing.T, s string) *ketoapi.RelationTuple {
+	rt, err := (&ketoapi.RelationTuple{}).FromString(s)
+	require.NoError(t, err)
+	return rt
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false)
+	h := playground.NewHandler(reg)
+
+	r := &x.OPLSyntaxRouter{Router: httprouter.New()}
+	h.RegisterSyntaxRoutes(r)
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	evaluate := func(t *testing.T, req *ketoapi.EvaluateOPLRequest) (*http.Response, *ketoapi.EvaluateOPLResponse) {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := ts.Client().Post(ts.URL+playground.RouteBase, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		if resp.StatusCode != http.StatusOK {
+			return resp, nil
+		}
+		var res ketoapi.EvaluateOPLResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return resp, &res
+	}
+
+	t.Run("proto=REST", func(t *testing.T) {
+		t.Run("case=checks and expands", func(t *testing.T) {
+			resp, res := evaluate(t, &ketoapi.EvaluateOPLRequest{
+				Schema: namespaces,
+				RelationTuples: []*ketoapi.RelationTuple{
+					tuple(t, "Document:readme#viewers@Group:eng#members"),
+					tuple(t, "Group:eng#members@User:bob"),
+				},
+				Checks: []*ketoapi.RelationTuple{
+					tuple(t, "Document:readme#view@User:bob"),
+					tuple(t, "Document:readme#view@User:alice"),
+					tuple(t, "Folder:f#view@User:bob"),
+				},
+				Expands: []*ketoapi.SubjectSet{{Namespace: "Document", Object: "readme", Relation: "viewers"}},
+			})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Empty(t, res.Errors)
+
+			require.Len(t, res.Checks, 3)
+			assert.True(t, res.Checks[0].Allowed)
+			assert.Empty(t, res.Checks[0].Error)
+			require.NotNil(t, res.Checks[0].Tree)
+			assert.False(t, res.Checks[1].Allowed)
+			assert.Equal(t, tuple(t, "Document:readme#view@User:alice"), res.Checks[1].RelationTuple)
+			assert.False(t, res.Checks[2].Allowed)
+			assert.Contains(t, res.Checks[2].Error, "Folder")
+
+			require.Len(t, res.Expands, 1)
+			require.NotNil(t, res.Expands[0].Tree)
+			assert.Contains(t, res.Expands[0].Tree.String(), "User:bob")
+		})
+
+		t.Run("case=store is discarded", func(t *testing.T) {
+			_, res := evaluate(t, &ketoapi.EvaluateOPLRequest{
+				Schema: namespaces,
+				Checks: []*ketoapi.RelationTuple{tuple(t, "Document:readme#view@User:bob")},
+			})
+			require.Len(t, res.Checks, 1)
+			assert.False(t, res.Checks[0].Allowed)
+
+			stored, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+			require.NoError(t, err)
+			assert.Empty(t, stored, "the real database must not be touched")
+		})
+
+		t.Run("case=parse errors", func(t *testing.T) {
+			resp, res := evaluate(t, &ketoapi.EvaluateOPLRequest{
+				Schema: "class Document implements Namespace {",
+				Checks: []*ketoapi.RelationTuple{tuple(t, "Document:readme#view@User:bob")},
+			})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.NotEmpty(t, res.Errors)
+			assert.Empty(t, res.Checks)
+		})
+
+		t.Run("case=relationships of unknown namespaces", func(t *testing.T) {
+			resp, _ := evaluate(t, &ketoapi.EvaluateOPLRequest{
+				Schema:         namespaces,
+				RelationTuples: []*ketoapi.RelationTuple{tuple(t, "Folder:f#viewers@User:bob")},
+			})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+
+		t.Run("case=invalid relationship", func(t *testing.T) {
+			resp, _ := evaluate(t, &ketoapi.EvaluateOPLRequest{
+				Schema: namespaces,
+				Checks: []*ketoapi.RelationTuple{{Namespace: "Document", Object: "readme", Relation: "view"}},
+			})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+
+		t.Run("case=too many checks", func(t *testing.T) {
+			checks := make([]*ketoapi.RelationTuple, 1001)
+			for i := range checks {
+				checks[i] = tuple(t, "Document:readme#view@User:bob")
+			}
+			resp, _ := evaluate(t, &ketoapi.EvaluateOPLRequest{Schema: namespaces, Checks: checks})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	})
+
+	t.Run("proto=gRPC", func(t *testing.T) {
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer(grpc.UnaryInterceptor(herodot.UnaryErrorUnwrapInterceptor))
+		h.RegisterSyntaxGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+		client := opl.NewPlaygroundServiceClient(conn)
+
+		t.Run("case=checks and expands", func(t *testing.T) {
+			res, err := client.Evaluate(ctx, &opl.EvaluateRequest{
+				Content:        []byte(namespaces),
+				RelationTuples: []*rts.RelationTuple{tuple(t, "Document:readme#viewers@User:bob").ToProto()},
+				Checks: []*rts.RelationTuple{
+					tuple(t, "Document:readme#view@User:bob").ToProto(),
+					(&ketoapi.RelationTuple{Namespace: "Document", Object: "readme", Relation: "view", SubjectID: pointerx.Ptr("alice")}).ToProto(),
+				},
+				Expands: []*rts.SubjectSet{{Namespace: "Document", Object: "readme", Relation: "viewers"}},
+			})
+			require.NoError(t, err)
+			require.Empty(t, res.ParseErrors)
+			require.Len(t, res.Checks, 2)
+			assert.True(t, res.Checks[0].Allowed)
+			assert.NotNil(t, res.Checks[0].Tree)
+			assert.False(t, res.Checks[1].Allowed)
+			require.Len(t, res.Expands, 1)
+			assert.NotNil(t, res.Expands[0].Tree)
+		})
+
+		t.Run("case=parse errors", func(t *testing.T) {
+			res, err := client.Evaluate(ctx, &opl.EvaluateRequest{Content: []byte("class Document implements Namespace {")})
+			require.NoError(t, err)
+			assert.NotEmpty(t, res.ParseErrors)
+		})
+
+		t.Run("case=missing subject", func(t *testing.T) {
+			_, err := client.Evaluate(ctx, &opl.EvaluateRequest{
+				Content: []byte(namespaces),
+				Checks:  []*rts.RelationTuple{{Namespace: "Document", Object: "readme", Relation: "view"}},
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package playground
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/ory/x/logrusx"
+
+	"github.com/ory/keto/internal/check"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/expand"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+)
+
+type (
+	// registry only knows the evaluated schema and relationships. Everything
+	// is kept in memory, no database is created per request.
+	registry struct {
+		c *config.Config
+		l *logrusx.Logger
+		m relationtuple.Manager
+		u *memoryMappingManager
+	}
+	// memoryMappingManager maps strings to UUIDs the same way as the
+	// persister, but remembers the mappings only in memory.
+	memoryMappingManager struct {
+		sync.RWMutex
+		strings map[uuid.UUID]string
+	}
+)
+
+var (
+	_ check.EngineDependencies  = (*registry)(nil)
+	_ expand.EngineDependencies = (*registry)(nil)
+
+	_ relationtuple.MappingManager = (*memoryMappingManager)(nil)
+)
+
+func newRegistry(c *config.Config, l *logrusx.Logger, namespaces []*namespace.Namespace) *registry {
+	return &registry{
+		c: c.WithNamespaces(namespaces),
+		l: l,
+		m: relationtuple.NewOverlayManager(nil, nil, nil),
+		u: &memoryMappingManager{strings: make(map[uuid.UUID]string)},
+	}
+}
+
+// writeRelationTuples replaces the relationships of the registry.
+func (r *registry) writeRelationTuples(rs []*relationtuple.RelationTuple) {
+	r.m = relationtuple.NewOverlayManager(nil, rs, nil)
+}
+
+func (r *registry) Config(context.Context) *config.Config {
+	return r.c
+}
+
+func (r *registry) Logger() *logrusx.Logger {
+	return r.l
+}
+
+func (r *registry) RelationTupleManager() relationtuple.Manager {
+	return r.m
+}
+
+func (r *registry) MappingManager() relationtuple.MappingManager {
+	return r.u
+}
+
+func (r *registry) Mapper() *relationtuple.Mapper {
+	return &relationtuple.Mapper{D: r}
+}
+
+func (m *memoryMappingManager) MapStringsToUUIDs(_ context.Context, s ...string) ([]uuid.UUID, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	ids := make([]uuid.UUID, len(s))
+	for i, val := range s {
+		ids[i] = uuid.NewV5(uuid.Nil, val)
+		m.strings[ids[i]] = val
+	}
+	return ids, nil
+}
+
+func (m *memoryMappingManager) MapUUIDsToStrings(_ context.Context, u ...uuid.UUID) ([]string, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	res := make([]string, len(u))
+	for i, id := range u {
+		res[i] = m.strings[id]
+	}
+	return res, nil
+}
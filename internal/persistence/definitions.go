@@ -6,11 +6,15 @@ package persistence
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/ory/x/popx"
 
 	"github.com/gobuffalo/pop/v6"
 
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/idempotency"
+	"github.com/ory/keto/internal/namespace"
 	"github.com/ory/keto/internal/relationtuple"
 )
 
@@ -18,6 +22,21 @@ type (
 	Persister interface {
 		relationtuple.Manager
 		relationtuple.MappingManager
+		relationtuple.Changelog
+		relationtuple.Importer
+		relationtuple.ObjectManager
+		relationtuple.SnaptokenManager
+		audit.Manager
+		idempotency.Manager
+		namespace.SchemaVersionManager
+
+		// CountSubjectTypes returns the number of stored relationships per
+		// namespace, relation, and subject type.
+		CountSubjectTypes(ctx context.Context) ([]*relationtuple.SubjectTypeCount, error)
+		// PurgeRelationTupleTombstones removes the relationships that were
+		// deleted before the given time in the history mode, and returns
+		// their number.
+		PurgeRelationTupleTombstones(ctx context.Context, before time.Time) (int, error)
 
 		Connection(ctx context.Context) *pop.Connection
 	}
@@ -0,0 +1,156 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+)
+
+type (
+	auditEntry struct {
+		ID          uuid.UUID `db:"id"`
+		NetworkID   uuid.UUID `db:"nid"`
+		Sequence    int64     `db:"seq"`
+		Operation   string    `db:"operation"`
+		Actor       string    `db:"actor"`
+		ActorSource string    `db:"actor_source"`
+		RequestID   string    `db:"request_id"`
+		CreatedAt   time.Time `db:"created_at"`
+	}
+	auditEntries []*auditEntry
+)
+
+// maxAuditValueLength is the length of the actor and request ID columns.
+const maxAuditValueLength = 255
+
+var _ audit.Manager = (*Persister)(nil)
+
+func (auditEntries) TableName() string {
+	return "keto_relation_tuple_audit_log"
+}
+
+func (auditEntry) TableName() string {
+	return "keto_relation_tuple_audit_log"
+}
+
+// recordAuditEntry records the changelog transaction in the audit log, if it is
+// enabled. The entry references the changes by the sequence number of the
+// transaction.
+func (p *Persister) recordAuditEntry(ctx context.Context, operation string, tx *changelogTransaction) error {
+	if !p.d.Config(ctx).AuditEnabled() {
+		return nil
+	}
+
+	req := audit.RequestFromContext(ctx)
+	requestID := req.RequestID
+	if requestID == "" {
+		requestID = uuid.Must(uuid.NewV4()).String()
+	}
+	return sqlcon.HandleError(p.createWithNetwork(ctx, &auditEntry{
+		ID:          uuid.Must(uuid.NewV4()),
+		Sequence:    tx.seq,
+		Operation:   operation,
+		Actor:       truncate(req.Actor, maxAuditValueLength),
+		ActorSource: req.ActorSource,
+		RequestID:   truncate(requestID, maxAuditValueLength),
+		CreatedAt:   tx.commitTime,
+	}))
+}
+
+func (p *Persister) ListAuditEntries(ctx context.Context, filter *audit.Filter, opts ...x.PaginationOptionSetter) (_ []*audit.Entry, nextPageToken string, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListAuditEntries")
+	defer otelx.End(span, &err)
+
+	pagination := x.GetPaginationOptions(opts...)
+	if pagination.Size <= 0 {
+		pagination.Size = defaultPageSize
+	}
+
+	q := p.queryWithNetwork(ctx).
+		Order("seq DESC").
+		Limit(pagination.Size + 1)
+	if pagination.Token != "" {
+		before, err := strconv.ParseInt(pagination.Token, 10, 64)
+		if err != nil {
+			return nil, "", errors.WithStack(herodot.ErrBadRequest.WithReasonf("Malformed page token %q.", pagination.Token))
+		}
+		q.Where("seq < ?", before)
+	}
+	if filter.Actor != "" {
+		q.Where("actor = ?", filter.Actor)
+	}
+	if filter.RequestID != "" {
+		q.Where("request_id = ?", filter.RequestID)
+	}
+
+	var rows auditEntries
+	if err := q.All(&rows); err != nil {
+		return nil, "", sqlcon.HandleError(err)
+	}
+	if len(rows) > pagination.Size {
+		rows = rows[:pagination.Size]
+		nextPageToken = strconv.FormatInt(rows[len(rows)-1].Sequence, 10)
+	}
+	if len(rows) == 0 {
+		return []*audit.Entry{}, "", nil
+	}
+
+	seqs := make([]interface{}, len(rows))
+	for i, r := range rows {
+		seqs[i] = r.Sequence
+	}
+	var changes relationTupleChanges
+	if err := p.queryWithNetwork(ctx).
+		Where("seq IN (?)", seqs...).
+		Order("seq, idx").
+		All(&changes); err != nil {
+		return nil, "", sqlcon.HandleError(err)
+	}
+	bySeq := make(map[int64][]*relationtuple.Change, len(rows))
+	for _, c := range changes {
+		ic, err := c.toInternal()
+		if err != nil {
+			return nil, "", err
+		}
+		bySeq[c.Sequence] = append(bySeq[c.Sequence], ic)
+	}
+
+	entries := make([]*audit.Entry, len(rows))
+	for i, r := range rows {
+		entries[i] = &audit.Entry{
+			Time:        r.CreatedAt,
+			Operation:   r.Operation,
+			Actor:       r.Actor,
+			ActorSource: r.ActorSource,
+			RequestID:   r.RequestID,
+			Changes:     bySeq[r.Sequence],
+		}
+	}
+	return entries, nextPageToken, nil
+}
+
+// truncate cuts s to at most n bytes, on a rune boundary so that the result
+// stays valid UTF-8.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,141 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/internal/x/dbx"
+	"github.com/ory/keto/ketoapi"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			tuple := func() *relationtuple.RelationTuple {
+				return &relationtuple.RelationTuple{
+					Namespace: "n",
+					Object:    uuid.Must(uuid.NewV4()),
+					Relation:  "r",
+					Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+				}
+			}
+			alice := audit.WithRequest(ctx, &audit.Request{Actor: "alice", ActorSource: audit.ActorSourceHeader, RequestID: "req-1"})
+			bob := audit.WithRequest(ctx, &audit.Request{Actor: "bob", ActorSource: audit.ActorSourceMTLS})
+
+			t.Run("case=writes are not recorded if disabled", func(t *testing.T) {
+				require.NoError(t, p.WriteRelationTuples(alice, tuple()))
+
+				entries, next, err := p.ListAuditEntries(ctx, &audit.Filter{})
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+				assert.Empty(t, next)
+			})
+
+			require.NoError(t, reg.Config(ctx).Set(config.KeyAuditEnabled, true))
+
+			t1, t2, t3 := tuple(), tuple(), tuple()
+			require.NoError(t, p.WriteRelationTuples(alice, t1, t2))
+			require.NoError(t, p.TransactRelationTuples(bob, []*relationtuple.RelationTuple{t3}, []*relationtuple.RelationTuple{t1}))
+			require.NoError(t, p.DeleteAllRelationTuples(alice, &relationtuple.RelationQuery{Object: &t2.Object}))
+
+			t.Run("case=lists all entries the latest first", func(t *testing.T) {
+				entries, next, err := p.ListAuditEntries(ctx, &audit.Filter{})
+				require.NoError(t, err)
+				assert.Empty(t, next)
+				require.Len(t, entries, 3)
+
+				assert.Equal(t, audit.OperationDeleteAll, entries[0].Operation)
+				require.Len(t, entries[0].Changes, 1)
+				assert.Equal(t, ketoapi.ActionDelete, entries[0].Changes[0].Action)
+				assert.Equal(t, t2, entries[0].Changes[0].RelationTuple)
+
+				assert.Equal(t, audit.OperationTransact, entries[1].Operation)
+				assert.Equal(t, "bob", entries[1].Actor)
+				assert.Equal(t, audit.ActorSourceMTLS, entries[1].ActorSource)
+				assert.NotEmpty(t, entries[1].RequestID, "a request ID is generated")
+				require.Len(t, entries[1].Changes, 2)
+				assert.Equal(t, t3, entries[1].Changes[0].RelationTuple)
+				assert.Equal(t, t1, entries[1].Changes[1].RelationTuple)
+
+				assert.Equal(t, audit.OperationWrite, entries[2].Operation)
+				assert.Equal(t, "alice", entries[2].Actor)
+				assert.Equal(t, audit.ActorSourceHeader, entries[2].ActorSource)
+				assert.Equal(t, "req-1", entries[2].RequestID)
+				require.Len(t, entries[2].Changes, 2)
+				assert.False(t, entries[2].Time.After(entries[1].Time))
+			})
+
+			t.Run("case=filters", func(t *testing.T) {
+				entries, _, err := p.ListAuditEntries(ctx, &audit.Filter{Actor: "alice"})
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+				assert.Equal(t, audit.OperationDeleteAll, entries[0].Operation)
+				assert.Equal(t, audit.OperationWrite, entries[1].Operation)
+
+				entries, _, err = p.ListAuditEntries(ctx, &audit.Filter{Actor: "alice", RequestID: "req-1"})
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+
+				entries, _, err = p.ListAuditEntries(ctx, &audit.Filter{Actor: "carol"})
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+			})
+
+			t.Run("case=paginates", func(t *testing.T) {
+				var all []*audit.Entry
+				next := ""
+				for i := 0; ; i++ {
+					require.Less(t, i, 3)
+					entries, nextPage, err := p.ListAuditEntries(ctx, &audit.Filter{}, x.WithSize(2), x.WithToken(next))
+					require.NoError(t, err)
+					all = append(all, entries...)
+					if nextPage == "" {
+						break
+					}
+					next = nextPage
+				}
+				require.Len(t, all, 3)
+				assert.Equal(t, audit.OperationDeleteAll, all[0].Operation)
+				assert.Equal(t, audit.OperationWrite, all[2].Operation)
+
+				_, _, err := p.ListAuditEntries(ctx, &audit.Filter{}, x.WithToken("not a token"))
+				assert.Error(t, err)
+			})
+
+			t.Run("case=truncates long values on rune boundaries", func(t *testing.T) {
+				// 300 two-byte runes exceed the column length of 255 bytes
+				actor := strings.Repeat("ä", 300)
+				require.NoError(t, p.WriteRelationTuples(audit.WithRequest(ctx, &audit.Request{Actor: actor}), tuple()))
+
+				entries, _, err := p.ListAuditEntries(ctx, &audit.Filter{}, x.WithSize(1))
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+				assert.True(t, utf8.ValidString(entries[0].Actor))
+				assert.Equal(t, strings.Repeat("ä", 127), entries[0].Actor)
+			})
+		})
+	}
+}
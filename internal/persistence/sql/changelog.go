@@ -0,0 +1,303 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+)
+
+type (
+	RelationTupleChange struct {
+		ID                  uuid.UUID      `db:"id"`
+		NetworkID           uuid.UUID      `db:"nid"`
+		Sequence            int64          `db:"seq"`
+		Index               int            `db:"idx"`
+		Action              string         `db:"action"`
+		Namespace           string         `db:"namespace"`
+		Object              uuid.UUID      `db:"object"`
+		Relation            string         `db:"relation"`
+		SubjectID           uuid.NullUUID  `db:"subject_id"`
+		SubjectSetNamespace sql.NullString `db:"subject_set_namespace"`
+		SubjectSetObject    uuid.NullUUID  `db:"subject_set_object"`
+		SubjectSetRelation  sql.NullString `db:"subject_set_relation"`
+		CommitTime          time.Time      `db:"commit_time"`
+	}
+	relationTupleChanges []*RelationTupleChange
+	changeSequence       struct {
+		NetworkID uuid.UUID `db:"nid"`
+		Sequence  int64     `db:"seq"`
+		// PrunedSequence is the latest transaction whose changes were deleted.
+		PrunedSequence int64 `db:"pruned_seq"`
+	}
+
+	// changelogTransaction numbers the changes of one transaction.
+	changelogTransaction struct {
+		seq        int64
+		next       int
+		commitTime time.Time
+	}
+	changelogTransactionKey struct{}
+)
+
+var _ relationtuple.Changelog = (*Persister)(nil)
+
+// relationTupleChangeColumns are the columns of a logged change.
+var relationTupleChangeColumns = []string{
+	"id", "nid", "seq", "idx", "action",
+	"namespace", "object", "relation",
+	"subject_id", "subject_set_namespace", "subject_set_object", "subject_set_relation",
+	"commit_time",
+}
+
+func (relationTupleChanges) TableName() string {
+	return "keto_relation_tuple_changes"
+}
+
+func (RelationTupleChange) TableName() string {
+	return "keto_relation_tuple_changes"
+}
+
+func (changeSequence) TableName() string {
+	return "keto_relation_tuple_change_sequences"
+}
+
+func (c *RelationTupleChange) toInternal() (*relationtuple.Change, error) {
+	rt, err := (&RelationTuple{
+		Namespace:           c.Namespace,
+		Object:              c.Object,
+		Relation:            c.Relation,
+		SubjectID:           c.SubjectID,
+		SubjectSetNamespace: c.SubjectSetNamespace,
+		SubjectSetObject:    c.SubjectSetObject,
+		SubjectSetRelation:  c.SubjectSetRelation,
+	}).toInternal()
+	if err != nil {
+		return nil, err
+	}
+	return &relationtuple.Change{
+		Action:        ketoapi.PatchAction(c.Action),
+		RelationTuple: rt,
+	}, nil
+}
+
+// changeTransaction runs f in a transaction that logs its changes to the
+// changelog. Nested calls share the transaction of the outermost call, whose
+// operation is recorded in the audit log.
+//
+// Every transaction takes the next number of the network's sequence. The row
+// of the sequence stays locked until the transaction commits, so the writers
+// of a network are serialized and the sequence numbers are in commit order.
+func (p *Persister) changeTransaction(ctx context.Context, operation string, f func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(changelogTransactionKey{}).(*changelogTransaction); ok {
+		return f(ctx)
+	}
+	return p.transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		seq, err := p.nextChangeSequence(ctx, c)
+		if err != nil {
+			return err
+		}
+		tx := &changelogTransaction{seq: seq, commitTime: time.Now().UTC()}
+		if err := f(context.WithValue(ctx, changelogTransactionKey{}, tx)); err != nil {
+			return err
+		}
+		return p.recordAuditEntry(ctx, operation, tx)
+	})
+}
+
+func (p *Persister) nextChangeSequence(ctx context.Context, c *pop.Connection) (int64, error) {
+	upsert := `INSERT INTO keto_relation_tuple_change_sequences (nid, seq) VALUES (?, 1)
+		ON CONFLICT (nid) DO UPDATE SET seq = keto_relation_tuple_change_sequences.seq + 1`
+	if c.Dialect.Name() == "mysql" {
+		upsert = `INSERT INTO keto_relation_tuple_change_sequences (nid, seq) VALUES (?, 1)
+			ON DUPLICATE KEY UPDATE seq = seq + 1`
+	}
+	if err := c.RawQuery(upsert, p.NetworkID(ctx)).Exec(); err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+
+	var seq changeSequence
+	if err := c.Where("nid = ?", p.NetworkID(ctx)).First(&seq); err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return seq.Sequence, nil
+}
+
+// changeTime returns the commit time of the changelog transaction.
+func changeTime(ctx context.Context) time.Time {
+	if tx, ok := ctx.Value(changelogTransactionKey{}).(*changelogTransaction); ok {
+		return tx.commitTime
+	}
+	return time.Now().UTC()
+}
+
+// logChanges appends the same change of all relationships to the changelog of
+// the transaction, with multi-row inserts.
+func (p *Persister) logChanges(ctx context.Context, action ketoapi.PatchAction, rs []*RelationTuple) error {
+	tx, ok := ctx.Value(changelogTransactionKey{}).(*changelogTransaction)
+	if !ok {
+		return errors.WithStack(herodot.ErrInternalServerError.WithReason("relationships must be changed in a changelog transaction"))
+	}
+
+	values := make([][]interface{}, len(rs))
+	for i, r := range rs {
+		values[i] = []interface{}{
+			uuid.Must(uuid.NewV4()), p.NetworkID(ctx), tx.seq, tx.next, string(action),
+			r.Namespace, r.Object, r.Relation,
+			r.SubjectID, r.SubjectSetNamespace, r.SubjectSetObject, r.SubjectSetRelation,
+			tx.commitTime,
+		}
+		tx.next++
+	}
+	return p.insertRows(ctx, RelationTupleChange{}.TableName(), relationTupleChangeColumns, values)
+}
+
+func (p *Persister) GetRelationTupleChanges(ctx context.Context, cursor string, limit int) (_ []*relationtuple.ChangelogEntry, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetRelationTupleChanges")
+	defer otelx.End(span, &err)
+
+	after, err := parseChangelogCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	// The log starts after the pruned changes.
+	var seq changeSequence
+	if err := p.queryWithNetwork(ctx).First(&seq); err != nil && !errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+		return nil, sqlcon.HandleError(err)
+	}
+	if cursor == "" {
+		after = seq.PrunedSequence
+	} else if after < seq.PrunedSequence {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("The changes after cursor %q were deleted from the changelog, see the watch.retention config. Resume from the latest cursor instead.", cursor))
+	}
+
+	var rows relationTupleChanges
+	if err := p.queryWithNetwork(ctx).
+		Where("seq > ?", after).
+		Order("seq, idx").
+		Limit(limit).
+		All(&rows); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	// The last transaction might be cut off by the limit. It is dropped, unless
+	// it is the only one, in which case all of its changes are returned.
+	if len(rows) == limit {
+		last := rows[len(rows)-1].Sequence
+		if rows[0].Sequence == last {
+			rows = nil
+			if err := p.queryWithNetwork(ctx).
+				Where("seq = ?", last).
+				Order("idx").
+				All(&rows); err != nil {
+				return nil, sqlcon.HandleError(err)
+			}
+		} else {
+			for rows[len(rows)-1].Sequence == last {
+				rows = rows[:len(rows)-1]
+			}
+		}
+	}
+
+	var entries []*relationtuple.ChangelogEntry
+	for _, r := range rows {
+		if len(entries) == 0 || entries[len(entries)-1].Cursor != formatChangelogCursor(r.Sequence) {
+			entries = append(entries, &relationtuple.ChangelogEntry{
+				Cursor:     formatChangelogCursor(r.Sequence),
+				CommitTime: r.CommitTime,
+			})
+		}
+		c, err := r.toInternal()
+		if err != nil {
+			return nil, err
+		}
+		entry := entries[len(entries)-1]
+		entry.Changes = append(entry.Changes, c)
+	}
+	return entries, nil
+}
+
+func (p *Persister) LatestChangelogCursor(ctx context.Context) (_ string, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.LatestChangelogCursor")
+	defer otelx.End(span, &err)
+
+	var seq changeSequence
+	err = p.queryWithNetwork(ctx).First(&seq)
+	if errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+		return formatChangelogCursor(0), nil
+	} else if err != nil {
+		return "", sqlcon.HandleError(err)
+	}
+	return formatChangelogCursor(seq.Sequence), nil
+}
+
+func (p *Persister) PruneRelationTupleChanges(ctx context.Context, before time.Time) (_ int, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.PruneRelationTupleChanges")
+	defer otelx.End(span, &err)
+
+	var pruned struct {
+		Sequence sql.NullInt64 `db:"seq"`
+	}
+	if err := p.Connection(ctx).RawQuery(
+		"SELECT MAX(seq) AS seq FROM keto_relation_tuple_changes WHERE nid = ? AND commit_time < ?",
+		p.NetworkID(ctx), before.UTC(),
+	).First(&pruned); err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	if !pruned.Sequence.Valid {
+		return 0, nil
+	}
+
+	// The pruned sequence is raised before the changes are deleted, so that
+	// watchers never skip deleted changes. It is a short write, because the row
+	// is also locked by all writers.
+	if err := p.Connection(ctx).RawQuery(
+		"UPDATE keto_relation_tuple_change_sequences SET pruned_seq = ? WHERE nid = ? AND pruned_seq < ?",
+		pruned.Sequence.Int64, p.NetworkID(ctx), pruned.Sequence.Int64,
+	).Exec(); err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+
+	// The changes of transactions in the audit log are its deltas.
+	count, err := p.Connection(ctx).RawQuery(
+		`DELETE FROM keto_relation_tuple_changes WHERE nid = ? AND seq <= ?
+			AND seq NOT IN (SELECT seq FROM keto_relation_tuple_audit_log WHERE nid = ?)`,
+		p.NetworkID(ctx), pruned.Sequence.Int64, p.NetworkID(ctx),
+	).ExecWithCount()
+	if err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return count, nil
+}
+
+func formatChangelogCursor(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+func parseChangelogCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	seq, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Malformed changelog cursor %q.", cursor))
+	}
+	return seq, nil
+}
@@ -0,0 +1,142 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x/dbx"
+	"github.com/ory/keto/ketoapi"
+)
+
+func TestRelationTupleChangelog(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			tuple := func() *relationtuple.RelationTuple {
+				return &relationtuple.RelationTuple{
+					Namespace: "n",
+					Object:    uuid.Must(uuid.NewV4()),
+					Relation:  "r",
+					Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+				}
+			}
+
+			start, err := p.LatestChangelogCursor(ctx)
+			require.NoError(t, err)
+
+			t1, t2, t3 := tuple(), tuple(), tuple()
+			require.NoError(t, p.WriteRelationTuples(ctx, t1, t2))
+			require.NoError(t, p.TransactRelationTuples(ctx, []*relationtuple.RelationTuple{t3}, []*relationtuple.RelationTuple{t1, tuple()}))
+
+			t.Run("case=changes are grouped by transaction", func(t *testing.T) {
+				entries, err := p.GetRelationTupleChanges(ctx, start, 0)
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+
+				require.Len(t, entries[0].Changes, 2)
+				assert.Equal(t, ketoapi.ActionInsert, entries[0].Changes[0].Action)
+				assert.Equal(t, t1, entries[0].Changes[0].RelationTuple)
+				assert.Equal(t, t2, entries[0].Changes[1].RelationTuple)
+
+				// the delete of the missing tuple is not logged
+				require.Len(t, entries[1].Changes, 2)
+				assert.Equal(t, ketoapi.ActionInsert, entries[1].Changes[0].Action)
+				assert.Equal(t, t3, entries[1].Changes[0].RelationTuple)
+				assert.Equal(t, ketoapi.ActionDelete, entries[1].Changes[1].Action)
+				assert.Equal(t, t1, entries[1].Changes[1].RelationTuple)
+
+				latest, err := p.LatestChangelogCursor(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, entries[1].Cursor, latest)
+			})
+
+			t.Run("case=resumes after the cursor", func(t *testing.T) {
+				entries, err := p.GetRelationTupleChanges(ctx, start, 0)
+				require.NoError(t, err)
+				require.Len(t, entries, 2)
+
+				rest, err := p.GetRelationTupleChanges(ctx, entries[0].Cursor, 0)
+				require.NoError(t, err)
+				require.Len(t, rest, 1)
+				assert.Equal(t, entries[1].Cursor, rest[0].Cursor)
+
+				none, err := p.GetRelationTupleChanges(ctx, entries[1].Cursor, 0)
+				require.NoError(t, err)
+				assert.Empty(t, none)
+			})
+
+			t.Run("case=limit does not split transactions", func(t *testing.T) {
+				entries, err := p.GetRelationTupleChanges(ctx, start, 3)
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+				assert.Len(t, entries[0].Changes, 2)
+
+				entries, err = p.GetRelationTupleChanges(ctx, start, 1)
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+				assert.Len(t, entries[0].Changes, 2)
+			})
+
+			t.Run("case=malformed cursor", func(t *testing.T) {
+				_, err := p.GetRelationTupleChanges(ctx, "not a cursor", 0)
+				assert.ErrorIs(t, err, herodot.ErrBadRequest)
+			})
+
+			t.Run("case=prunes old changes", func(t *testing.T) {
+				require.NoError(t, reg.Config(ctx).Set(config.KeyAuditEnabled, true))
+				t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyAuditEnabled, false)) })
+				audited := tuple()
+				require.NoError(t, p.WriteRelationTuples(ctx, audited))
+				latest, err := p.LatestChangelogCursor(ctx)
+				require.NoError(t, err)
+
+				n, err := p.PruneRelationTupleChanges(ctx, time.Now().Add(time.Minute))
+				require.NoError(t, err)
+				assert.Equal(t, 4, n)
+
+				_, err = p.GetRelationTupleChanges(ctx, start, 0)
+				assert.ErrorIs(t, err, herodot.ErrBadRequest)
+
+				// the log starts after the pruned changes
+				entries, err := p.GetRelationTupleChanges(ctx, "", 0)
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+
+				// the changes of the audit log are kept
+				logged, _, err := p.ListAuditEntries(ctx, &audit.Filter{})
+				require.NoError(t, err)
+				require.Len(t, logged, 1)
+				require.Len(t, logged[0].Changes, 1)
+				assert.Equal(t, audited, logged[0].Changes[0].RelationTuple)
+
+				t4 := tuple()
+				require.NoError(t, p.WriteRelationTuples(ctx, t4))
+				entries, err = p.GetRelationTupleChanges(ctx, latest, 0)
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+				assert.Equal(t, t4, entries[0].Changes[0].RelationTuple)
+			})
+		})
+	}
+}
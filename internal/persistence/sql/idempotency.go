@@ -0,0 +1,94 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/idempotency"
+)
+
+type idempotencyRecord struct {
+	ID          uuid.UUID      `db:"id"`
+	NetworkID   uuid.UUID      `db:"nid"`
+	Key         string         `db:"idempotency_key"`
+	RequestHash string         `db:"request_hash"`
+	Response    sql.NullString `db:"response"`
+	CreatedAt   time.Time      `db:"created_at"`
+	ExpiresAt   time.Time      `db:"expires_at"`
+}
+
+var _ idempotency.Manager = (*Persister)(nil)
+
+func (idempotencyRecord) TableName() string {
+	return "keto_idempotency_keys"
+}
+
+func (p *Persister) ClaimIdempotencyKey(ctx context.Context, key, requestHash string, now, leaseExpiresAt time.Time) (_ *idempotency.Record, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ClaimIdempotencyKey")
+	defer otelx.End(span, &err)
+
+	var existing *idempotency.Record
+	err = p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+		// Expired records of the network, including the claims of requests
+		// that never completed, are cleaned up by the next claim.
+		if err := p.queryWithNetwork(ctx).Where("expires_at <= ?", now).Delete(&idempotencyRecord{}); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		rec, err := p.getIdempotencyRecord(ctx, key)
+		if err != nil || rec != nil {
+			existing = rec
+			return err
+		}
+		return sqlcon.HandleError(p.createWithNetwork(ctx, &idempotencyRecord{
+			ID:          uuid.Must(uuid.NewV4()),
+			Key:         key,
+			RequestHash: requestHash,
+			CreatedAt:   now,
+			ExpiresAt:   leaseExpiresAt,
+		}))
+	})
+	if errors.Is(err, sqlcon.ErrUniqueViolation) {
+		// A concurrent request claimed the key first.
+		return p.getIdempotencyRecord(ctx, key)
+	}
+	return existing, err
+}
+
+func (p *Persister) getIdempotencyRecord(ctx context.Context, key string) (*idempotency.Record, error) {
+	var r idempotencyRecord
+	err := p.queryWithNetwork(ctx).Where("idempotency_key = ?", key).First(&r)
+	if errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &idempotency.Record{RequestHash: r.RequestHash, Response: r.Response.String}, nil
+}
+
+func (p *Persister) CompleteIdempotencyKey(ctx context.Context, key, response string, expiresAt time.Time) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CompleteIdempotencyKey")
+	defer otelx.End(span, &err)
+
+	_, err = p.queryWithNetwork(ctx).
+		Where("idempotency_key = ?", key).
+		UpdateQuery(&idempotencyRecord{Response: sql.NullString{String: response, Valid: true}, ExpiresAt: expiresAt}, "response", "expires_at")
+	return sqlcon.HandleError(err)
+}
+
+func (p *Persister) ReleaseIdempotencyKey(ctx context.Context, key string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ReleaseIdempotencyKey")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.queryWithNetwork(ctx).Where("idempotency_key = ?", key).Delete(&idempotencyRecord{}))
+}
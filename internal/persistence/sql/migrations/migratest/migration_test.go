@@ -143,10 +143,15 @@ func TestMigrations(t *testing.T) {
 					t.Log("status after up migration")
 					logMigrationStatus(t, tm)
 
-					// Assert that relationtuples have UUIDs
-					tuples, _, err := p.GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: &namespaces[1].Name})
-					require.NoError(t, err)
-					assert.NotZero(t, tuples[0].Subject.(*relationtuple.SubjectID).ID)
+					// Assert that relationtuples have UUIDs. The persister reads
+					// the latest schema, so the table is queried directly.
+					var tuples []*tuplesAfterUUID
+					require.NoError(t, p.Connection(ctx).
+						Select("subject_id", "object").
+						Where("namespace = ?", namespaces[1].Name).
+						All(&tuples))
+					require.NotEmpty(t, tuples)
+					assert.NotZero(t, tuples[0].SubjectID.UUID)
 					assert.NotZero(t, tuples[0].Object)
 
 					// Migrate down to before "migrate-strings-to-uuids"
@@ -206,6 +211,47 @@ func TestMigrations(t *testing.T) {
 				})
 			})
 
+			t.Run("suite=history_migration", func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+				defer cancel()
+				require.NoError(t, tm.Down(ctx, -1))
+				require.NoError(t, tm.Up(ctx))
+
+				live, deleted := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+				for _, obj := range []uuid.UUID{live, deleted} {
+					require.NoError(t, p.WriteRelationTuples(ctx, &relationtuple.RelationTuple{
+						Namespace: namespaces[1].Name,
+						Object:    obj,
+						Relation:  "history",
+						Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+					}))
+				}
+				require.NoError(t, p.Connection(ctx).
+					RawQuery("UPDATE keto_relation_tuples SET deleted_at = ? WHERE nid = ? AND object = ?", time.Now(), p.NetworkID(ctx), deleted).
+					Exec())
+
+				// Migrate down to before "relation-tuple-history", which
+				// removes the deleted relationships
+				migrateDownTo(t, tm, "20230415000000000000")
+				t.Log("status after down migration")
+				logMigrationStatus(t, tm)
+
+				var tuples []*tuplesAfterUUID
+				require.NoError(t, p.Connection(ctx).
+					Select("subject_id", "object").
+					Where("nid = ? AND relation = ?", p.NetworkID(ctx), "history").
+					All(&tuples))
+				require.Len(t, tuples, 1)
+				assert.Equal(t, live, tuples[0].Object)
+
+				// The remaining relationships are not deleted after migrating up
+				require.NoError(t, tm.Up(ctx))
+				actual, _, err := p.GetRelationTuples(ctx, &relationtuple.RelationQuery{Relation: pointerx.Ptr("history")})
+				require.NoError(t, err)
+				require.Len(t, actual, 1)
+				assert.Equal(t, live, actual[0].Object)
+			})
+
 			t.Run("suite=down", func(t *testing.T) {
 				if debugOnDisk && db.Name == "sqlite" {
 					t.SkipNow()
@@ -238,7 +284,14 @@ func migrateDownTo(t *testing.T, tm *popx.MigrationBox, version string) {
 
 	for i, status := range statuses {
 		if status.Version == version {
-			require.NoError(t, tm.Down(context.Background(), len(statuses)-i))
+			// only the applied migrations are migrated down
+			steps := 0
+			for _, s := range statuses[i:] {
+				if s.State == popx.Applied {
+					steps++
+				}
+			}
+			require.NoError(t, tm.Down(context.Background(), steps))
 			return
 		}
 	}
@@ -271,3 +324,12 @@ type tuplesBeforeUUID struct {
 func (tuplesBeforeUUID) TableName(_ context.Context) string {
 	return "keto_relation_tuples"
 }
+
+type tuplesAfterUUID struct {
+	Object    uuid.UUID     `db:"object"`
+	SubjectID uuid.NullUUID `db:"subject_id"`
+}
+
+func (tuplesAfterUUID) TableName(_ context.Context) string {
+	return "keto_relation_tuples"
+}
@@ -0,0 +1,19 @@
+-- duplicates of the relationships of 20210623162417_testdata.sql, which the deduplication removes
+INSERT INTO keto_relation_tuples (shard_id, nid, namespace, object, relation, subject_id, subject_set_namespace,
+                                  subject_set_object, subject_set_relation, commit_time)
+VALUES ('3a7c3c1d-54b4-4f05-a1a4-1f0d5a6c3e01', '77fdc5e0-2260-49da-8aae-c36ba255d05b', 'foo',
+        'f9f0e775-a8ea-53a0-9e0a-44533cda4ad5', 'relation', 'aecb444d-e651-533a-b605-1f84fc50e226',
+        NULL, NULL, NULL, '2023-05-14 00:00:00');
+
+INSERT INTO keto_relation_tuples (shard_id, nid, namespace, object, relation, subject_id, subject_set_namespace,
+                                  subject_set_object, subject_set_relation, commit_time)
+VALUES ('3a7c3c1d-54b4-4f05-a1a4-1f0d5a6c3e02', '77fdc5e0-2260-49da-8aae-c36ba255d05b', 'foo',
+        'f9f0e775-a8ea-53a0-9e0a-44533cda4ad5', 'relation', NULL,
+        'foo', '6a7ade7d-0db1-5d3f-aa8b-822a47e5faf1', 's_relation', '2023-05-14 00:00:00');
+
+-- deleted relationships of the history mode are kept
+INSERT INTO keto_relation_tuples (shard_id, nid, namespace, object, relation, subject_id, subject_set_namespace,
+                                  subject_set_object, subject_set_relation, commit_time, deleted_at)
+VALUES ('3a7c3c1d-54b4-4f05-a1a4-1f0d5a6c3e03', '77fdc5e0-2260-49da-8aae-c36ba255d05b', 'foo',
+        'f9f0e775-a8ea-53a0-9e0a-44533cda4ad5', 'relation', 'aecb444d-e651-533a-b605-1f84fc50e226',
+        NULL, NULL, NULL, '2023-05-13 00:00:00', '2023-05-13 12:00:00');
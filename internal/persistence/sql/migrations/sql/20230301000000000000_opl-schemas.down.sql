@@ -0,0 +1 @@
+DROP TABLE keto_opl_schemas;
@@ -0,0 +1,11 @@
+CREATE TABLE keto_opl_schemas
+(
+    id                       CHAR(36)    NOT NULL,
+    nid                      CHAR(36)    NOT NULL,
+    version                  INT         NOT NULL,
+    content                  TEXT        NOT NULL,
+    created_at               TIMESTAMP   NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT keto_opl_schemas_nid_fk FOREIGN KEY (nid) REFERENCES networks (id),
+    CONSTRAINT keto_opl_schemas_nid_version_uq UNIQUE (nid, version)
+);
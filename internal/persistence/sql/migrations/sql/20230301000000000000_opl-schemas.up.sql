@@ -0,0 +1,10 @@
+CREATE TABLE keto_opl_schemas
+(
+    id                       UUID        NOT NULL PRIMARY KEY,
+    nid                      UUID        NOT NULL,
+    version                  INT         NOT NULL,
+    content                  TEXT        NOT NULL,
+    created_at               TIMESTAMP   NOT NULL,
+    CONSTRAINT keto_opl_schemas_nid_fk FOREIGN KEY (nid) REFERENCES networks (id),
+    CONSTRAINT keto_opl_schemas_nid_version_uq UNIQUE (nid, version)
+);
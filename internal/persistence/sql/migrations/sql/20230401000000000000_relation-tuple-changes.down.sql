@@ -0,0 +1,2 @@
+DROP TABLE keto_relation_tuple_changes;
+DROP TABLE keto_relation_tuple_change_sequences;
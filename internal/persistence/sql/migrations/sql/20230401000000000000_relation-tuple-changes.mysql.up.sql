@@ -0,0 +1,27 @@
+CREATE TABLE keto_relation_tuple_change_sequences
+(
+    nid                      CHAR(36)    NOT NULL,
+    seq                      BIGINT      NOT NULL,
+    PRIMARY KEY (nid),
+    CONSTRAINT keto_relation_tuple_change_sequences_nid_fk FOREIGN KEY (nid) REFERENCES networks (id)
+);
+
+CREATE TABLE keto_relation_tuple_changes
+(
+    id                       CHAR(36)    NOT NULL,
+    nid                      CHAR(36)    NOT NULL,
+    seq                      BIGINT      NOT NULL,
+    idx                      INT         NOT NULL,
+    action                   VARCHAR(8)  NOT NULL,
+    namespace                VARCHAR(200) NOT NULL,
+    object                   CHAR(36)    NOT NULL,
+    relation                 VARCHAR(64) NOT NULL,
+    subject_id               CHAR(36) NULL,
+    subject_set_namespace    VARCHAR(200) NULL,
+    subject_set_object       CHAR(36) NULL,
+    subject_set_relation     VARCHAR(64) NULL,
+    commit_time              TIMESTAMP   NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT keto_relation_tuple_changes_nid_fk FOREIGN KEY (nid) REFERENCES networks (id),
+    CONSTRAINT keto_relation_tuple_changes_seq_uq UNIQUE (nid, seq, idx)
+);
@@ -0,0 +1,26 @@
+CREATE TABLE keto_relation_tuple_change_sequences
+(
+    nid                      UUID        NOT NULL PRIMARY KEY,
+    seq                      BIGINT      NOT NULL,
+    CONSTRAINT keto_relation_tuple_change_sequences_nid_fk FOREIGN KEY (nid) REFERENCES networks (id)
+);
+
+CREATE TABLE keto_relation_tuple_changes
+(
+    id                       UUID        NOT NULL PRIMARY KEY,
+    nid                      UUID        NOT NULL,
+    seq                      BIGINT      NOT NULL,
+    idx                      INT         NOT NULL,
+    action                   VARCHAR(8)  NOT NULL,
+    namespace                VARCHAR(200) NOT NULL,
+    object                   UUID        NOT NULL,
+    relation                 VARCHAR(64) NOT NULL,
+    subject_id               UUID NULL,
+    subject_set_namespace    VARCHAR(200) NULL,
+    subject_set_object       UUID NULL,
+    subject_set_relation     VARCHAR(64) NULL,
+    commit_time              TIMESTAMP   NOT NULL,
+    CONSTRAINT keto_relation_tuple_changes_nid_fk FOREIGN KEY (nid) REFERENCES networks (id)
+);
+
+CREATE UNIQUE INDEX keto_relation_tuple_changes_seq_idx ON keto_relation_tuple_changes (nid, seq, idx);
@@ -0,0 +1,2 @@
+DELETE FROM keto_relation_tuples WHERE deleted_at IS NOT NULL;
+ALTER TABLE keto_relation_tuples DROP COLUMN deleted_at;
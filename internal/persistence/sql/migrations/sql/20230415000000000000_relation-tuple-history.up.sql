@@ -0,0 +1 @@
+ALTER TABLE keto_relation_tuples ADD COLUMN deleted_at TIMESTAMP NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE keto_relation_tuple_audit_log
+(
+    id                       CHAR(36)     NOT NULL,
+    nid                      CHAR(36)     NOT NULL,
+    seq                      BIGINT       NOT NULL,
+    operation                VARCHAR(32)  NOT NULL,
+    actor                    VARCHAR(255) NOT NULL,
+    actor_source             VARCHAR(16)  NOT NULL,
+    request_id               VARCHAR(255) NOT NULL,
+    created_at               TIMESTAMP    NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT keto_relation_tuple_audit_log_nid_fk FOREIGN KEY (nid) REFERENCES networks (id),
+    CONSTRAINT keto_relation_tuple_audit_log_seq_uq UNIQUE (nid, seq),
+    INDEX keto_relation_tuple_audit_log_actor_idx (nid, actor, seq),
+    INDEX keto_relation_tuple_audit_log_request_idx (nid, request_id)
+);
@@ -0,0 +1,16 @@
+CREATE TABLE keto_relation_tuple_audit_log
+(
+    id                       UUID         NOT NULL PRIMARY KEY,
+    nid                      UUID         NOT NULL,
+    seq                      BIGINT       NOT NULL,
+    operation                VARCHAR(32)  NOT NULL,
+    actor                    VARCHAR(255) NOT NULL,
+    actor_source             VARCHAR(16)  NOT NULL,
+    request_id               VARCHAR(255) NOT NULL,
+    created_at               TIMESTAMP    NOT NULL,
+    CONSTRAINT keto_relation_tuple_audit_log_nid_fk FOREIGN KEY (nid) REFERENCES networks (id)
+);
+
+CREATE UNIQUE INDEX keto_relation_tuple_audit_log_seq_idx ON keto_relation_tuple_audit_log (nid, seq);
+CREATE INDEX keto_relation_tuple_audit_log_actor_idx ON keto_relation_tuple_audit_log (nid, actor, seq);
+CREATE INDEX keto_relation_tuple_audit_log_request_idx ON keto_relation_tuple_audit_log (nid, request_id);
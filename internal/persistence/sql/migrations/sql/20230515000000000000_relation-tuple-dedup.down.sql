@@ -0,0 +1 @@
+-- The removed duplicates are not restored.
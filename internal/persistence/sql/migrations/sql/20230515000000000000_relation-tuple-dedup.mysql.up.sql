@@ -0,0 +1,31 @@
+-- Keeps the first written of every set of equal relationships.
+DELETE duplicate
+FROM keto_relation_tuples AS duplicate
+         JOIN keto_relation_tuples AS other
+              ON other.nid = duplicate.nid
+                  AND other.namespace = duplicate.namespace
+                  AND other.object = duplicate.object
+                  AND other.relation = duplicate.relation
+                  AND other.subject_id = duplicate.subject_id
+                  AND other.deleted_at IS NULL
+                  AND (other.commit_time < duplicate.commit_time OR
+                       (other.commit_time = duplicate.commit_time AND other.shard_id < duplicate.shard_id))
+WHERE duplicate.deleted_at IS NULL
+  AND duplicate.subject_id IS NOT NULL;
+
+DELETE duplicate
+FROM keto_relation_tuples AS duplicate
+         JOIN keto_relation_tuples AS other
+              ON other.nid = duplicate.nid
+                  AND other.namespace = duplicate.namespace
+                  AND other.object = duplicate.object
+                  AND other.relation = duplicate.relation
+                  AND other.subject_id IS NULL
+                  AND other.subject_set_namespace = duplicate.subject_set_namespace
+                  AND other.subject_set_object = duplicate.subject_set_object
+                  AND other.subject_set_relation = duplicate.subject_set_relation
+                  AND other.deleted_at IS NULL
+                  AND (other.commit_time < duplicate.commit_time OR
+                       (other.commit_time = duplicate.commit_time AND other.shard_id < duplicate.shard_id))
+WHERE duplicate.deleted_at IS NULL
+  AND duplicate.subject_id IS NULL;
@@ -0,0 +1,31 @@
+-- Keeps the first written of every set of equal relationships.
+DELETE FROM keto_relation_tuples
+WHERE deleted_at IS NULL
+  AND subject_id IS NOT NULL
+  AND EXISTS (SELECT 1
+              FROM keto_relation_tuples AS other
+              WHERE other.nid = keto_relation_tuples.nid
+                AND other.namespace = keto_relation_tuples.namespace
+                AND other.object = keto_relation_tuples.object
+                AND other.relation = keto_relation_tuples.relation
+                AND other.subject_id = keto_relation_tuples.subject_id
+                AND other.deleted_at IS NULL
+                AND (other.commit_time < keto_relation_tuples.commit_time OR
+                     (other.commit_time = keto_relation_tuples.commit_time AND other.shard_id < keto_relation_tuples.shard_id)));
+
+DELETE FROM keto_relation_tuples
+WHERE deleted_at IS NULL
+  AND subject_id IS NULL
+  AND EXISTS (SELECT 1
+              FROM keto_relation_tuples AS other
+              WHERE other.nid = keto_relation_tuples.nid
+                AND other.namespace = keto_relation_tuples.namespace
+                AND other.object = keto_relation_tuples.object
+                AND other.relation = keto_relation_tuples.relation
+                AND other.subject_id IS NULL
+                AND other.subject_set_namespace = keto_relation_tuples.subject_set_namespace
+                AND other.subject_set_object = keto_relation_tuples.subject_set_object
+                AND other.subject_set_relation = keto_relation_tuples.subject_set_relation
+                AND other.deleted_at IS NULL
+                AND (other.commit_time < keto_relation_tuples.commit_time OR
+                     (other.commit_time = keto_relation_tuples.commit_time AND other.shard_id < keto_relation_tuples.shard_id)));
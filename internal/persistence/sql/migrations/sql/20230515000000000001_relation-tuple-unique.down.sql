@@ -0,0 +1,2 @@
+DROP INDEX keto_relation_tuples_unique_subject_ids_idx;
+DROP INDEX keto_relation_tuples_unique_subject_sets_idx;
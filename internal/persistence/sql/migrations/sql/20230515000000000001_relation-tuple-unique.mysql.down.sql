@@ -0,0 +1,5 @@
+DROP INDEX keto_relation_tuples_unique_subject_ids_idx ON keto_relation_tuples;
+DROP INDEX keto_relation_tuples_unique_subject_sets_idx ON keto_relation_tuples;
+ALTER TABLE keto_relation_tuples
+    DROP COLUMN live_subject_id,
+    DROP COLUMN live_subject_set_namespace;
@@ -0,0 +1,22 @@
+-- mysql has no partial indexes, so the unique indexes are over columns that
+-- are NULL for the rows that are not unique, i.e. the other subject type and the
+-- deleted relationships of the history mode.
+ALTER TABLE keto_relation_tuples
+    ADD COLUMN live_subject_id CHAR(36) GENERATED ALWAYS AS (IF(deleted_at IS NULL, subject_id, NULL)) VIRTUAL,
+    ADD COLUMN live_subject_set_namespace VARCHAR(200) GENERATED ALWAYS AS (IF(deleted_at IS NULL, subject_set_namespace, NULL)) VIRTUAL;
+
+CREATE UNIQUE INDEX keto_relation_tuples_unique_subject_ids_idx ON keto_relation_tuples (nid,
+                                                                                         namespace,
+                                                                                         object,
+                                                                                         relation,
+                                                                                         live_subject_id
+    );
+
+CREATE UNIQUE INDEX keto_relation_tuples_unique_subject_sets_idx ON keto_relation_tuples (nid,
+                                                                                          namespace,
+                                                                                          object,
+                                                                                          relation,
+                                                                                          live_subject_set_namespace,
+                                                                                          subject_set_object,
+                                                                                          subject_set_relation
+    );
@@ -0,0 +1,16 @@
+-- Deleted relationships of the history mode are not unique.
+CREATE UNIQUE INDEX keto_relation_tuples_unique_subject_ids_idx ON keto_relation_tuples (nid,
+                                                                                         namespace,
+                                                                                         object,
+                                                                                         relation,
+                                                                                         subject_id
+    ) WHERE subject_id IS NOT NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX keto_relation_tuples_unique_subject_sets_idx ON keto_relation_tuples (nid,
+                                                                                          namespace,
+                                                                                          object,
+                                                                                          relation,
+                                                                                          subject_set_namespace,
+                                                                                          subject_set_object,
+                                                                                          subject_set_relation
+    ) WHERE subject_id IS NULL AND deleted_at IS NULL;
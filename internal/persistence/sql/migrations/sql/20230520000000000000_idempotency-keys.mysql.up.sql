@@ -0,0 +1,14 @@
+CREATE TABLE keto_idempotency_keys
+(
+    id                       CHAR(36)     NOT NULL,
+    nid                      CHAR(36)     NOT NULL,
+    idempotency_key          VARCHAR(255) NOT NULL,
+    request_hash             CHAR(64)     NOT NULL,
+    response                 MEDIUMTEXT   NULL,
+    created_at               TIMESTAMP    NOT NULL,
+    expires_at               TIMESTAMP    NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT keto_idempotency_keys_nid_fk FOREIGN KEY (nid) REFERENCES networks (id),
+    CONSTRAINT keto_idempotency_keys_key_uq UNIQUE (nid, idempotency_key),
+    INDEX keto_idempotency_keys_expires_at_idx (nid, expires_at)
+);
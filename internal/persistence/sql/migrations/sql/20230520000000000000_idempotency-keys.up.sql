@@ -0,0 +1,14 @@
+CREATE TABLE keto_idempotency_keys
+(
+    id                       UUID         NOT NULL PRIMARY KEY,
+    nid                      UUID         NOT NULL,
+    idempotency_key          VARCHAR(255) NOT NULL,
+    request_hash             CHAR(64)     NOT NULL,
+    response                 TEXT         NULL,
+    created_at               TIMESTAMP    NOT NULL,
+    expires_at               TIMESTAMP    NOT NULL,
+    CONSTRAINT keto_idempotency_keys_nid_fk FOREIGN KEY (nid) REFERENCES networks (id)
+);
+
+CREATE UNIQUE INDEX keto_idempotency_keys_key_idx ON keto_idempotency_keys (nid, idempotency_key);
+CREATE INDEX keto_idempotency_keys_expires_at_idx ON keto_idempotency_keys (nid, expires_at);
@@ -0,0 +1 @@
+ALTER TABLE keto_relation_tuple_change_sequences DROP COLUMN pruned_seq;
@@ -0,0 +1 @@
+ALTER TABLE keto_relation_tuple_change_sequences ADD COLUMN pruned_seq BIGINT NOT NULL DEFAULT 0;
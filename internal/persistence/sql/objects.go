@@ -0,0 +1,93 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/audit"
+	"github.com/ory/keto/internal/relationtuple"
+)
+
+var _ relationtuple.ObjectManager = (*Persister)(nil)
+
+// whereObject restricts the query to the relationships of the object, and the
+// relationships with a subject set of the object.
+func (p *Persister) whereObject(q *pop.Query, namespace string, object uuid.UUID) {
+	q.Where("((namespace = ? AND object = ?) OR (subject_set_namespace = ? AND subject_set_object = ?))",
+		namespace, object, namespace, object)
+}
+
+func (p *Persister) DeleteObject(ctx context.Context, namespace string, object uuid.UUID) (_ int, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteObject")
+	defer otelx.End(span, &err)
+
+	var deleted int
+	err = p.changeTransaction(ctx, audit.OperationDeleteObject, func(ctx context.Context) error {
+		rows, err := p.deleteAndLog(ctx, func(q *pop.Query) error {
+			p.whereObject(q, namespace, object)
+			return nil
+		})
+		deleted = len(rows)
+		return err
+	})
+	return deleted, err
+}
+
+// RenameObject rewrites the relationships under the new object, so that the
+// changes are logged and deleted relationships are kept in history mode.
+func (p *Persister) RenameObject(ctx context.Context, namespace string, object, newObject uuid.UUID) (_ int, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RenameObject")
+	defer otelx.End(span, &err)
+
+	if object == newObject {
+		return 0, errors.WithStack(herodot.ErrBadRequest.WithReason("The new object has to be different from the object."))
+	}
+
+	var renamed int
+	err = p.changeTransaction(ctx, audit.OperationRenameObject, func(ctx context.Context) error {
+		q := p.queryWithNetwork(ctx).Where("deleted_at IS NULL")
+		p.whereObject(q, namespace, newObject)
+		exists, err := q.Exists(&RelationTuple{})
+		if err != nil {
+			return sqlcon.HandleError(err)
+		} else if exists {
+			return errors.WithStack(herodot.ErrConflict.WithReason("The new object already has relationships."))
+		}
+
+		rows, err := p.deleteAndLog(ctx, func(q *pop.Query) error {
+			p.whereObject(q, namespace, object)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		rs := make([]*relationtuple.RelationTuple, len(rows))
+		for i, r := range rows {
+			rt, err := r.toInternal()
+			if err != nil {
+				return err
+			}
+			if rt.Namespace == namespace && rt.Object == object {
+				rt.Object = newObject
+			}
+			if s, ok := rt.Subject.(*relationtuple.SubjectSet); ok && s.Namespace == namespace && s.Object == object {
+				s.Object = newObject
+			}
+			rs[i] = rt
+		}
+		renamed = len(rs)
+		_, err = p.insertAndLog(ctx, rs)
+		return err
+	})
+	return renamed, err
+}
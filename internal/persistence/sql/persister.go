@@ -6,24 +6,30 @@ package sql
 import (
 	"context"
 	"embed"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/popx"
+	"github.com/ory/x/sqlcon"
 	"github.com/pkg/errors"
 
+	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/persistence"
+	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
 	"github.com/ory/keto/ketoctx"
 )
 
 type (
 	Persister struct {
-		conn *pop.Connection
-		d    dependencies
-		nid  uuid.UUID
+		conn     *pop.Connection
+		readConn *pop.Connection
+		d        dependencies
+		nid      uuid.UUID
 	}
 	internalPagination struct {
 		PerPage int
@@ -33,13 +39,18 @@ type (
 		x.LoggerProvider
 		x.TracingProvider
 		ketoctx.ContextualizerProvider
+		config.Provider
 
 		PopConnection(ctx context.Context) (*pop.Connection, error)
+		ReadPopConnection(ctx context.Context) (*pop.Connection, error)
 	}
 )
 
 const (
 	defaultPageSize int = 100
+	// maxInsertParameters bounds the parameters of one multi-row insert, which
+	// all dialects limit.
+	maxInsertParameters = 10000
 )
 
 var (
@@ -54,11 +65,16 @@ func NewPersister(ctx context.Context, reg dependencies, nid uuid.UUID) (*Persis
 	if err != nil {
 		return nil, err
 	}
+	readConn, err := reg.ReadPopConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	p := &Persister{
-		d:    reg,
-		nid:  nid,
-		conn: conn,
+		d:        reg,
+		nid:      nid,
+		conn:     conn,
+		readConn: readConn,
 	}
 
 	return p, nil
@@ -68,6 +84,16 @@ func (p *Persister) Connection(ctx context.Context) *pop.Connection {
 	return popx.GetConnection(ctx, p.conn.WithContext(ctx))
 }
 
+// readConnection returns the connection for reads that may lag behind the
+// writes. It is the connection of the read replica, unless the context is in a
+// transaction or requires the primary database.
+func (p *Persister) readConnection(ctx context.Context) *pop.Connection {
+	if relationtuple.ReadsPrimary(ctx) {
+		return p.Connection(ctx)
+	}
+	return popx.GetConnection(ctx, p.readConn.WithContext(ctx))
+}
+
 func (p *Persister) createWithNetwork(ctx context.Context, v interface{}) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.createWithNetwork")
 	defer otelx.End(span, &err)
@@ -86,10 +112,43 @@ func (p *Persister) createWithNetwork(ctx context.Context, v interface{}) (err e
 	return p.Connection(ctx).Create(v)
 }
 
+// insertRows inserts the rows with as few multi-row inserts as the parameter
+// limit allows. Every row has the values of the columns in order.
+func (p *Persister) insertRows(ctx context.Context, table string, columns []string, rows [][]interface{}) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.insertRows")
+	defer otelx.End(span, &err)
+
+	placeholder := "(?" + strings.Repeat(", ?", len(columns)-1) + ")"
+	perStatement := maxInsertParameters / len(columns)
+	for len(rows) > 0 {
+		n := perStatement
+		if n > len(rows) {
+			n = len(rows)
+		}
+
+		placeholders := make([]string, n)
+		args := make([]interface{}, 0, n*len(columns))
+		for i, row := range rows[:n] {
+			placeholders[i] = placeholder
+			args = append(args, row...)
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+		if err := p.Connection(ctx).RawQuery(query, args...).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
+
 func (p *Persister) queryWithNetwork(ctx context.Context) *pop.Query {
 	return p.Connection(ctx).Where("nid = ?", p.NetworkID(ctx))
 }
 
+func (p *Persister) readQueryWithNetwork(ctx context.Context) *pop.Query {
+	return p.readConnection(ctx).Where("nid = ?", p.NetworkID(ctx))
+}
+
 func (p *Persister) transaction(ctx context.Context, f func(ctx context.Context, c *pop.Connection) error) error {
 	return popx.Transaction(ctx, p.conn.WithContext(ctx), f)
 }
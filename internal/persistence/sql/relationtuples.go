@@ -6,6 +6,7 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/ory/keto/ketoapi"
@@ -16,6 +17,7 @@ import (
 	"github.com/ory/x/sqlcon"
 	"github.com/pkg/errors"
 
+	"github.com/ory/keto/internal/audit"
 	"github.com/ory/keto/internal/relationtuple"
 	"github.com/ory/keto/internal/x"
 )
@@ -33,10 +35,35 @@ type (
 		SubjectSetObject    uuid.NullUUID  `db:"subject_set_object"`
 		SubjectSetRelation  sql.NullString `db:"subject_set_relation"`
 		CommitTime          time.Time      `db:"commit_time"`
+		DeletedAt           sql.NullTime   `db:"deleted_at"`
 	}
 	relationTuples []*RelationTuple
+	// relationTupleKey identifies a relationship regardless of its row.
+	relationTupleKey struct {
+		Namespace           string
+		Object              uuid.UUID
+		Relation            string
+		SubjectID           uuid.NullUUID
+		SubjectSetNamespace sql.NullString
+		SubjectSetObject    uuid.NullUUID
+		SubjectSetRelation  sql.NullString
+	}
 )
 
+// deleteChunkSize is the number of relationships that are deleted with one
+// statement. Every relationship adds a condition or a parameter, and SQLite
+// limits the depth of the expression tree to 1000 and, on older builds, the
+// number of parameters to 999.
+const deleteChunkSize = 500
+
+// relationTupleColumns are the columns of a written relationship, in the order
+// of RelationTuple.values.
+var relationTupleColumns = []string{
+	"shard_id", "nid", "namespace", "object", "relation",
+	"subject_id", "subject_set_namespace", "subject_set_object", "subject_set_relation",
+	"commit_time",
+}
+
 func (relationTuples) TableName() string {
 	return "keto_relation_tuples"
 }
@@ -71,6 +98,26 @@ func (r *RelationTuple) toInternal() (*relationtuple.RelationTuple, error) {
 	return rt, nil
 }
 
+func (r *RelationTuple) key() relationTupleKey {
+	return relationTupleKey{
+		Namespace:           r.Namespace,
+		Object:              r.Object,
+		Relation:            r.Relation,
+		SubjectID:           r.SubjectID,
+		SubjectSetNamespace: r.SubjectSetNamespace,
+		SubjectSetObject:    r.SubjectSetObject,
+		SubjectSetRelation:  r.SubjectSetRelation,
+	}
+}
+
+func (r *RelationTuple) values() []interface{} {
+	return []interface{}{
+		r.ID, r.NetworkID, r.Namespace, r.Object, r.Relation,
+		r.SubjectID, r.SubjectSetNamespace, r.SubjectSetObject, r.SubjectSetRelation,
+		r.CommitTime,
+	}
+}
+
 func (r *RelationTuple) insertSubject(_ context.Context, s relationtuple.Subject) error {
 	switch st := s.(type) {
 	case *relationtuple.SubjectID:
@@ -101,30 +148,6 @@ func (r *RelationTuple) FromInternal(ctx context.Context, p *Persister, rt *rela
 	return r.insertSubject(ctx, rt.Subject)
 }
 
-func (p *Persister) InsertRelationTuple(ctx context.Context, rel *relationtuple.RelationTuple) (err error) {
-	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.InsertRelationTuple")
-	defer otelx.End(span, &err)
-
-	if rel.Subject == nil {
-		return errors.WithStack(ketoapi.ErrNilSubject)
-	}
-
-	rt := &RelationTuple{
-		ID:         uuid.Must(uuid.NewV4()),
-		CommitTime: time.Now(),
-	}
-	if err := rt.FromInternal(ctx, p, rel); err != nil {
-		return err
-	}
-
-	if err := sqlcon.HandleError(
-		p.createWithNetwork(ctx, rt),
-	); err != nil {
-		return err
-	}
-	return nil
-}
-
 func (p *Persister) whereSubject(_ context.Context, q *pop.Query, sub relationtuple.Subject) error {
 	switch s := sub.(type) {
 	case *relationtuple.SubjectID:
@@ -147,6 +170,18 @@ func (p *Persister) whereSubject(_ context.Context, q *pop.Query, sub relationtu
 	return nil
 }
 
+// whereVisible restricts the query to the relationships that exist as of the
+// time in the context, or to the current relationships.
+func (p *Persister) whereVisible(ctx context.Context, q *pop.Query) {
+	if asOf, ok := relationtuple.AsOf(ctx); ok {
+		q.
+			Where("commit_time <= ?", asOf).
+			Where("(deleted_at IS NULL OR deleted_at > ?)", asOf)
+		return
+	}
+	q.Where("deleted_at IS NULL")
+}
+
 func (p *Persister) whereQuery(ctx context.Context, q *pop.Query, rq *relationtuple.RelationQuery) error {
 	if rq.Namespace != nil {
 		q.Where("namespace = ?", rq.Namespace)
@@ -165,42 +200,90 @@ func (p *Persister) whereQuery(ctx context.Context, q *pop.Query, rq *relationtu
 	return nil
 }
 
+// whereTuples restricts the query to the given relationships.
+func (p *Persister) whereTuples(_ context.Context, q *pop.Query, rs []*relationtuple.RelationTuple) error {
+	conditions := make([]string, len(rs))
+	args := make([]interface{}, 0, len(rs)*6)
+	for i, r := range rs {
+		switch s := r.Subject.(type) {
+		case *relationtuple.SubjectID:
+			conditions[i] = "(namespace = ? AND object = ? AND relation = ? AND subject_id = ?" +
+				" AND subject_set_namespace IS NULL AND subject_set_object IS NULL AND subject_set_relation IS NULL)"
+			args = append(args, r.Namespace, r.Object, r.Relation, s.ID)
+		case *relationtuple.SubjectSet:
+			conditions[i] = "(namespace = ? AND object = ? AND relation = ?" +
+				" AND subject_set_namespace = ? AND subject_set_object = ? AND subject_set_relation = ? AND subject_id IS NULL)"
+			args = append(args, r.Namespace, r.Object, r.Relation, s.Namespace, s.Object, s.Relation)
+		default:
+			return errors.WithStack(ketoapi.ErrNilSubject)
+		}
+	}
+	q.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	return nil
+}
+
 func (p *Persister) DeleteRelationTuples(ctx context.Context, rs ...*relationtuple.RelationTuple) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteRelationTuples")
 	defer otelx.End(span, &err)
 
-	return p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
-		for _, r := range rs {
-			q := p.queryWithNetwork(ctx).
-				Where("namespace = ?", r.Namespace).
-				Where("object = ?", r.Object).
-				Where("relation = ?", r.Relation)
-			if err := p.whereSubject(ctx, q, r.Subject); err != nil {
+	return p.changeTransaction(ctx, audit.OperationDelete, func(ctx context.Context) error {
+		for _, rs := range chunk(rs, deleteChunkSize) {
+			if _, err := p.deleteAndLog(ctx, func(q *pop.Query) error {
+				return p.whereTuples(ctx, q, rs)
+			}); err != nil {
 				return err
 			}
+		}
+		return nil
+	})
+}
 
-			if err := q.Delete(&RelationTuple{}); err != nil {
-				return err
+// deleteAndLog deletes the relationships selected by where, logs a change for
+// every deleted relationship, and returns them. It must be called in a
+// changelog transaction, which prevents concurrent writes between reading and
+// deleting the relationships. In the history mode, the relationships are kept
+// with their deletion time instead.
+func (p *Persister) deleteAndLog(ctx context.Context, where func(q *pop.Query) error) (relationTuples, error) {
+	selectQuery := p.queryWithNetwork(ctx).Where("deleted_at IS NULL")
+	if err := where(selectQuery); err != nil {
+		return nil, err
+	}
+	var deleted relationTuples
+	if err := selectQuery.All(&deleted); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	if len(deleted) == 0 {
+		return nil, nil
+	}
+
+	for _, rows := range chunk(deleted, deleteChunkSize) {
+		ids := make([]interface{}, len(rows))
+		for i, r := range rows {
+			ids[i] = r.ID
+		}
+		deleteQuery := p.queryWithNetwork(ctx).Where("shard_id IN (?)", ids...)
+		if p.d.Config(ctx).HistoryEnabled() {
+			tombstone := &RelationTuple{DeletedAt: sql.NullTime{Time: changeTime(ctx), Valid: true}}
+			if _, err := deleteQuery.UpdateQuery(tombstone, "deleted_at"); err != nil {
+				return nil, sqlcon.HandleError(err)
 			}
+		} else if err := deleteQuery.Delete(&RelationTuple{}); err != nil {
+			return nil, sqlcon.HandleError(err)
 		}
+	}
 
-		return nil
-	})
+	return deleted, p.logChanges(ctx, ketoapi.ActionDelete, deleted)
 }
 
 func (p *Persister) DeleteAllRelationTuples(ctx context.Context, query *relationtuple.RelationQuery) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteAllRelationTuples")
 	defer otelx.End(span, &err)
 
-	return p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
-		sqlQuery := p.queryWithNetwork(ctx)
-		err := p.whereQuery(ctx, sqlQuery, query)
-		if err != nil {
-			return err
-		}
-
-		var res relationTuples
-		return sqlQuery.Delete(&res)
+	return p.changeTransaction(ctx, audit.OperationDeleteAll, func(ctx context.Context) error {
+		_, err := p.deleteAndLog(ctx, func(q *pop.Query) error {
+			return p.whereQuery(ctx, q, query)
+		})
+		return err
 	})
 }
 
@@ -213,10 +296,11 @@ func (p *Persister) GetRelationTuples(ctx context.Context, query *relationtuple.
 		return nil, "", err
 	}
 
-	sqlQuery := p.queryWithNetwork(ctx).
+	sqlQuery := p.readQueryWithNetwork(ctx).
 		Order("shard_id, nid").
 		Where("shard_id > ?", pagination.LastID).
 		Limit(pagination.PerPage + 1)
+	p.whereVisible(ctx, sqlQuery)
 
 	err = p.whereQuery(ctx, sqlQuery, query)
 	if err != nil {
@@ -250,24 +334,184 @@ func (p *Persister) WriteRelationTuples(ctx context.Context, rs ...*relationtupl
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.WriteRelationTuples")
 	defer otelx.End(span, &err)
 
-	return p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
-		for _, r := range rs {
-			if err := p.InsertRelationTuple(ctx, r); err != nil {
-				return err
-			}
-		}
-		return nil
+	return p.changeTransaction(ctx, audit.OperationWrite, func(ctx context.Context) error {
+		_, err := p.insertAndLog(ctx, rs)
+		return err
+	})
+}
+
+// ImportRelationTuples writes the relationships in one transaction, and returns
+// how many of them did not exist yet. It is recorded as an import in the audit
+// log.
+func (p *Persister) ImportRelationTuples(ctx context.Context, rs ...*relationtuple.RelationTuple) (imported int, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ImportRelationTuples")
+	defer otelx.End(span, &err)
+
+	err = p.changeTransaction(ctx, audit.OperationImport, func(ctx context.Context) error {
+		inserted, err := p.insertAndLog(ctx, rs)
+		imported = len(inserted)
+		return err
 	})
+	return imported, err
 }
 
-func (p *Persister) TransactRelationTuples(ctx context.Context, ins []*relationtuple.RelationTuple, del []*relationtuple.RelationTuple) (err error) {
+// insertAndLog inserts the relationships that do not exist yet with
+// multi-row inserts, logs a change for every inserted relationship, and returns
+// them. It must be called in a changelog transaction, which prevents concurrent
+// writes between reading the existing and inserting the new relationships. The
+// unique indexes over the relationships enforce this on all dialects.
+func (p *Persister) insertAndLog(ctx context.Context, rs []*relationtuple.RelationTuple) (relationTuples, error) {
+	if len(rs) == 0 {
+		return nil, nil
+	}
+
+	existing := make(map[relationTupleKey]struct{}, len(rs))
+	for _, rs := range chunk(rs, deleteChunkSize) {
+		q := p.queryWithNetwork(ctx).Where("deleted_at IS NULL")
+		if err := p.whereTuples(ctx, q, rs); err != nil {
+			return nil, err
+		}
+		var found relationTuples
+		if err := q.All(&found); err != nil {
+			return nil, sqlcon.HandleError(err)
+		}
+		for _, r := range found {
+			existing[r.key()] = struct{}{}
+		}
+	}
+
+	rows := make([]*RelationTuple, 0, len(rs))
+	values := make([][]interface{}, 0, len(rs))
+	for _, r := range rs {
+		row := &RelationTuple{
+			ID:         uuid.Must(uuid.NewV4()),
+			NetworkID:  p.NetworkID(ctx),
+			Namespace:  r.Namespace,
+			Object:     r.Object,
+			Relation:   r.Relation,
+			CommitTime: changeTime(ctx),
+		}
+		if err := row.insertSubject(ctx, r.Subject); err != nil {
+			return nil, err
+		}
+		if _, ok := existing[row.key()]; ok {
+			continue
+		}
+		existing[row.key()] = struct{}{}
+		rows = append(rows, row)
+		values = append(values, row.values())
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	if err := p.insertRows(ctx, RelationTuple{}.TableName(), relationTupleColumns, values); err != nil {
+		return nil, err
+	}
+	return rows, p.logChanges(ctx, ketoapi.ActionInsert, rows)
+}
+
+func (p *Persister) TransactRelationTuples(ctx context.Context, ins []*relationtuple.RelationTuple, del []*relationtuple.RelationTuple, preconditions ...*relationtuple.Precondition) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.TransactRelationTuples")
 	defer otelx.End(span, &err)
 
-	return p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+	_, err = p.transactRelationTuples(ctx, ins, del, preconditions)
+	return err
+}
+
+// transactRelationTuples applies the deltas in one changelog transaction and
+// returns its sequence number.
+func (p *Persister) transactRelationTuples(ctx context.Context, ins []*relationtuple.RelationTuple, del []*relationtuple.RelationTuple, preconditions []*relationtuple.Precondition) (seq int64, err error) {
+	err = p.changeTransaction(ctx, audit.OperationTransact, func(ctx context.Context) error {
+		seq = ctx.Value(changelogTransactionKey{}).(*changelogTransaction).seq
+		if err := p.checkPreconditions(ctx, preconditions); err != nil {
+			return err
+		}
 		if err := p.WriteRelationTuples(ctx, ins...); err != nil {
 			return err
 		}
 		return p.DeleteRelationTuples(ctx, del...)
 	})
+	return seq, err
+}
+
+// checkPreconditions returns an error if any of the preconditions does not
+// hold. It must be called in a changelog transaction, which prevents
+// concurrent writes between the check and the commit.
+func (p *Persister) checkPreconditions(ctx context.Context, preconditions []*relationtuple.Precondition) error {
+	for i, pc := range preconditions {
+		q := p.queryWithNetwork(ctx).Where("deleted_at IS NULL")
+		var err error
+		switch {
+		case pc.TupleExists != nil:
+			err = p.whereTuples(ctx, q, []*relationtuple.RelationTuple{pc.TupleExists})
+		case pc.TupleNotExists != nil:
+			err = p.whereTuples(ctx, q, []*relationtuple.RelationTuple{pc.TupleNotExists})
+		case pc.NoMatch != nil:
+			err = p.whereQuery(ctx, q, pc.NoMatch)
+		default:
+			return errors.WithStack(ketoapi.ErrInvalidPrecondition)
+		}
+		if err != nil {
+			return err
+		}
+
+		exists, err := q.Exists(&RelationTuple{})
+		if err != nil {
+			return sqlcon.HandleError(err)
+		}
+		switch {
+		case pc.TupleExists != nil && !exists:
+			return errors.WithStack(relationtuple.ErrPreconditionFailed.WithReasonf("The relationship of precondition %d does not exist.", i))
+		case pc.TupleNotExists != nil && exists:
+			return errors.WithStack(relationtuple.ErrPreconditionFailed.WithReasonf("The relationship of precondition %d exists.", i))
+		case pc.NoMatch != nil && exists:
+			return errors.WithStack(relationtuple.ErrPreconditionFailed.WithReasonf("Relationships match the query of precondition %d.", i))
+		}
+	}
+	return nil
+}
+
+func (p *Persister) CountSubjectTypes(ctx context.Context) (_ []*relationtuple.SubjectTypeCount, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CountSubjectTypes")
+	defer otelx.End(span, &err)
+
+	var res []*relationtuple.SubjectTypeCount
+	if err := p.Connection(ctx).RawQuery(`
+		SELECT namespace, relation,
+			COALESCE(subject_set_namespace, '') AS subject_set_namespace,
+			COALESCE(subject_set_relation, '') AS subject_set_relation,
+			COUNT(*) AS count
+		FROM keto_relation_tuples
+		WHERE nid = ? AND deleted_at IS NULL
+		GROUP BY namespace, relation, subject_set_namespace, subject_set_relation`,
+		p.NetworkID(ctx),
+	).All(&res); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return res, nil
+}
+
+func (p *Persister) PurgeRelationTupleTombstones(ctx context.Context, before time.Time) (_ int, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.PurgeRelationTupleTombstones")
+	defer otelx.End(span, &err)
+
+	n, err := p.Connection(ctx).RawQuery(
+		"DELETE FROM keto_relation_tuples WHERE nid = ? AND deleted_at < ?",
+		p.NetworkID(ctx), before.UTC(),
+	).ExecWithCount()
+	return n, sqlcon.HandleError(err)
+}
+
+// chunk splits the slice into chunks of at most size elements.
+func chunk[T any](s []T, size int) [][]T {
+	var chunks [][]T
+	for len(s) > size {
+		chunks = append(chunks, s[:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
 }
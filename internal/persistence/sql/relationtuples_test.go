@@ -13,12 +13,17 @@ import (
 	"github.com/ory/x/networkx"
 
 	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/persistence/sql"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
 	"github.com/ory/keto/internal/x/dbx"
+	"github.com/ory/keto/ketoapi"
 )
 
 func rt(nw *networkx.Network, setSID, setNID, setO, setR bool) *sql.RelationTuple {
@@ -111,3 +116,303 @@ func TestRelationTupleSubjectTypeCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestCountSubjectTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			obj := uuid.Must(uuid.NewV4())
+			require.NoError(t, p.WriteRelationTuples(ctx,
+				&relationtuple.RelationTuple{Namespace: "Document", Object: obj, Relation: "viewers", Subject: &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())}},
+				&relationtuple.RelationTuple{Namespace: "Document", Object: obj, Relation: "viewers", Subject: &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())}},
+				&relationtuple.RelationTuple{Namespace: "Document", Object: obj, Relation: "viewers", Subject: &relationtuple.SubjectSet{Namespace: "Group", Object: uuid.Must(uuid.NewV4()), Relation: "members"}},
+				&relationtuple.RelationTuple{Namespace: "Group", Object: obj, Relation: "members", Subject: &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())}},
+			))
+
+			counts, err := p.CountSubjectTypes(ctx)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []*relationtuple.SubjectTypeCount{
+				{Namespace: "Document", Relation: "viewers", Count: 2},
+				{Namespace: "Document", Relation: "viewers", SubjectSetNamespace: "Group", SubjectSetRelation: "members", Count: 1},
+				{Namespace: "Group", Relation: "members", Count: 1},
+			}, counts)
+		})
+	}
+}
+
+func TestRelationTupleHistory(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, true))
+			p := reg.Persister()
+
+			obj := uuid.Must(uuid.NewV4())
+			tuple := func(relation string) *relationtuple.RelationTuple {
+				return &relationtuple.RelationTuple{Namespace: "n", Object: obj, Relation: relation, Subject: &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())}}
+			}
+			query := &relationtuple.RelationQuery{Object: &obj}
+			list := func(t *testing.T, ctx context.Context) []*relationtuple.RelationTuple {
+				res, _, err := p.GetRelationTuples(ctx, query)
+				require.NoError(t, err)
+				return res
+			}
+			asOf := func(t *testing.T, at time.Time) context.Context {
+				ctx, err := relationtuple.WithAsOf(ctx, reg, &at)
+				require.NoError(t, err)
+				return ctx
+			}
+			// the commit times of the following writes are strictly after this
+			tick := func() time.Time {
+				now := time.Now()
+				time.Sleep(10 * time.Millisecond)
+				return now
+			}
+
+			beforeInsert := tick()
+			a, b := tuple("a"), tuple("b")
+			require.NoError(t, p.WriteRelationTuples(ctx, a, b))
+			beforeDelete := tick()
+			require.NoError(t, p.DeleteRelationTuples(ctx, a))
+			beforeDeleteAll := tick()
+			require.NoError(t, p.DeleteAllRelationTuples(ctx, query))
+
+			assert.Empty(t, list(t, ctx))
+			assert.Empty(t, list(t, asOf(t, beforeInsert)))
+			assert.ElementsMatch(t, []*relationtuple.RelationTuple{a, b}, list(t, asOf(t, beforeDelete)))
+			assert.ElementsMatch(t, []*relationtuple.RelationTuple{b}, list(t, asOf(t, beforeDeleteAll)))
+
+			counts, err := p.CountSubjectTypes(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, counts)
+
+			t.Run("case=inserting again does not change the past", func(t *testing.T) {
+				beforeReinsert := tick()
+				require.NoError(t, p.WriteRelationTuples(ctx, a))
+
+				assert.ElementsMatch(t, []*relationtuple.RelationTuple{a}, list(t, ctx))
+				assert.ElementsMatch(t, []*relationtuple.RelationTuple{b}, list(t, asOf(t, beforeDeleteAll)))
+				assert.Empty(t, list(t, asOf(t, beforeReinsert)))
+			})
+
+			t.Run("case=requires the history mode", func(t *testing.T) {
+				require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, false))
+				t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, true)) })
+
+				now := time.Now()
+				_, err := relationtuple.WithAsOf(ctx, reg, &now)
+				assert.ErrorIs(t, err, relationtuple.ErrHistoryDisabled)
+			})
+
+			t.Run("case=reads before the retention fail", func(t *testing.T) {
+				require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryRetention, "1h"))
+				t.Cleanup(func() { require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryRetention, "720h")) })
+
+				old := time.Now().Add(-2 * time.Hour)
+				_, err := relationtuple.WithAsOf(ctx, reg, &old)
+				assert.ErrorIs(t, err, herodot.ErrBadRequest)
+			})
+
+			t.Run("case=purges tombstones", func(t *testing.T) {
+				// a and b were deleted, a was inserted again
+				n, err := p.PurgeRelationTupleTombstones(ctx, time.Now())
+				require.NoError(t, err)
+				assert.Equal(t, 2, n)
+
+				assert.ElementsMatch(t, []*relationtuple.RelationTuple{a}, list(t, ctx))
+				assert.Empty(t, list(t, asOf(t, beforeDeleteAll)))
+			})
+		})
+	}
+}
+
+func TestImportRelationTuples(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			// more than fit into one multi-row insert
+			ns := uuid.Must(uuid.NewV4()).String()
+			tuples := make([]*relationtuple.RelationTuple, 1500)
+			for i := range tuples {
+				tuples[i] = &relationtuple.RelationTuple{
+					Namespace: ns,
+					Object:    uuid.Must(uuid.NewV4()),
+					Relation:  "r",
+					Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+				}
+			}
+			tuples[0].Subject = &relationtuple.SubjectSet{Namespace: ns, Object: uuid.Must(uuid.NewV4()), Relation: "r"}
+
+			start, err := p.LatestChangelogCursor(ctx)
+			require.NoError(t, err)
+			imported, err := p.ImportRelationTuples(ctx, tuples...)
+			require.NoError(t, err)
+			assert.Equal(t, len(tuples), imported)
+
+			actual, next, err := p.GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: &ns}, x.WithSize(len(tuples)+1))
+			require.NoError(t, err)
+			assert.Empty(t, next)
+			assert.ElementsMatch(t, tuples, actual)
+
+			entries, err := p.GetRelationTupleChanges(ctx, start, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Len(t, entries[0].Changes, len(tuples))
+			for i, c := range entries[0].Changes {
+				assert.Equal(t, tuples[i], c.RelationTuple)
+			}
+
+			t.Run("case=rejects nil subjects", func(t *testing.T) {
+				_, err := p.ImportRelationTuples(ctx, &relationtuple.RelationTuple{Namespace: ns, Relation: "r"})
+				assert.ErrorIs(t, err, ketoapi.ErrNilSubject)
+			})
+		})
+	}
+}
+
+func TestWriteAndDeleteInChunks(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			// more than fit into one statement
+			ns := uuid.Must(uuid.NewV4()).String()
+			tuples := make([]*relationtuple.RelationTuple, 2000)
+			for i := range tuples {
+				tuples[i] = &relationtuple.RelationTuple{
+					Namespace: ns,
+					Object:    uuid.Must(uuid.NewV4()),
+					Relation:  "r",
+					Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+				}
+				if i%2 == 0 {
+					tuples[i].Subject = &relationtuple.SubjectSet{Namespace: ns, Object: uuid.Must(uuid.NewV4()), Relation: "r"}
+				}
+			}
+			require.NoError(t, p.WriteRelationTuples(ctx, tuples...))
+
+			start, err := p.LatestChangelogCursor(ctx)
+			require.NoError(t, err)
+
+			missing := &relationtuple.RelationTuple{
+				Namespace: ns,
+				Object:    tuples[0].Object,
+				Relation:  "other",
+				Subject:   tuples[0].Subject,
+			}
+			require.NoError(t, p.DeleteRelationTuples(ctx, append(tuples[:1800:1800], missing)...))
+
+			actual, _, err := p.GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: &ns}, x.WithSize(len(tuples)))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tuples[1800:], actual)
+
+			entries, err := p.GetRelationTupleChanges(ctx, start, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			deleted := make([]*relationtuple.RelationTuple, len(entries[0].Changes))
+			for i, c := range entries[0].Changes {
+				assert.Equal(t, ketoapi.ActionDelete, c.Action)
+				deleted[i] = c.RelationTuple
+			}
+			assert.ElementsMatch(t, tuples[:1800], deleted)
+		})
+	}
+}
+
+func TestIdempotentWrites(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			ns := uuid.Must(uuid.NewV4()).String()
+			query := &relationtuple.RelationQuery{Namespace: &ns}
+			tuple := func() *relationtuple.RelationTuple {
+				return &relationtuple.RelationTuple{
+					Namespace: ns,
+					Object:    uuid.Must(uuid.NewV4()),
+					Relation:  "r",
+					Subject:   &relationtuple.SubjectSet{Namespace: ns, Object: uuid.Must(uuid.NewV4()), Relation: "r"},
+				}
+			}
+			changes := func(t *testing.T, after string) []*relationtuple.Change {
+				entries, err := p.GetRelationTupleChanges(ctx, after, 0)
+				require.NoError(t, err)
+				var changes []*relationtuple.Change
+				for _, e := range entries {
+					changes = append(changes, e.Changes...)
+				}
+				return changes
+			}
+
+			t1, t2 := tuple(), tuple()
+			start, err := p.LatestChangelogCursor(ctx)
+			require.NoError(t, err)
+			require.NoError(t, p.WriteRelationTuples(ctx, t1, t1, t2))
+			require.NoError(t, p.WriteRelationTuples(ctx, t2))
+			imported, err := p.ImportRelationTuples(ctx, t1)
+			require.NoError(t, err)
+			assert.Zero(t, imported)
+
+			actual, _, err := p.GetRelationTuples(ctx, query)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []*relationtuple.RelationTuple{t1, t2}, actual)
+			assert.Len(t, changes(t, start), 2, "only the first writes are logged")
+
+			t.Run("case=history mode", func(t *testing.T) {
+				require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, true))
+				t.Cleanup(func() {
+					require.NoError(t, reg.Config(ctx).Set(config.KeyHistoryEnabled, false))
+				})
+
+				cursor, err := p.LatestChangelogCursor(ctx)
+				require.NoError(t, err)
+
+				// a deleted relationship can be written again
+				require.NoError(t, p.DeleteRelationTuples(ctx, t1))
+				require.NoError(t, p.WriteRelationTuples(ctx, t1))
+				require.NoError(t, p.WriteRelationTuples(ctx, t1))
+
+				actual, _, err := p.GetRelationTuples(ctx, query)
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []*relationtuple.RelationTuple{t1, t2}, actual)
+				assert.Len(t, changes(t, cursor), 2)
+			})
+		})
+	}
+}
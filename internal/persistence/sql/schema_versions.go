@@ -0,0 +1,123 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/ory/herodot"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/namespace"
+)
+
+type (
+	SchemaVersion struct {
+		ID        uuid.UUID `db:"id"`
+		NetworkID uuid.UUID `db:"nid"`
+		Version   int       `db:"version"`
+		Content   string    `db:"content"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	SchemaVersions []*SchemaVersion
+)
+
+var _ namespace.SchemaVersionManager = &Persister{}
+
+func (SchemaVersions) TableName() string {
+	return "keto_opl_schemas"
+}
+
+func (SchemaVersion) TableName() string {
+	return "keto_opl_schemas"
+}
+
+func (v *SchemaVersion) toInternal() *namespace.SchemaVersion {
+	return &namespace.SchemaVersion{
+		Version:   v.Version,
+		Content:   v.Content,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+func (p *Persister) AddSchemaVersion(ctx context.Context, content string) (_ *namespace.SchemaVersion, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.AddSchemaVersion")
+	defer otelx.End(span, &err)
+
+	v := &SchemaVersion{
+		ID:        uuid.Must(uuid.NewV4()),
+		Content:   content,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	// Concurrent writes get the same version number, of which all but one
+	// fail on the unique constraint.
+	if err := p.transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+		latest, err := p.latestSchemaVersion(ctx)
+		if err != nil {
+			return err
+		}
+		v.Version = latest + 1
+		return sqlcon.HandleError(p.createWithNetwork(ctx, v))
+	}); err != nil {
+		return nil, err
+	}
+
+	return v.toInternal(), nil
+}
+
+func (p *Persister) latestSchemaVersion(ctx context.Context) (int, error) {
+	var v SchemaVersion
+	err := p.queryWithNetwork(ctx).Order("version DESC").First(&v)
+	if errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return v.Version, nil
+}
+
+func (p *Persister) GetSchemaVersion(ctx context.Context, version int) (_ *namespace.SchemaVersion, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetSchemaVersion")
+	defer otelx.End(span, &err)
+
+	q := p.queryWithNetwork(ctx)
+	if version == 0 {
+		q = q.Order("version DESC")
+	} else {
+		q = q.Where("version = ?", version)
+	}
+
+	var v SchemaVersion
+	if err := q.First(&v); err != nil {
+		if errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+			if version == 0 {
+				return nil, errors.WithStack(herodot.ErrNotFound.WithReason("No schema version has been stored yet."))
+			}
+			return nil, errors.WithStack(herodot.ErrNotFound.WithReasonf("Unknown schema version %d.", version))
+		}
+		return nil, sqlcon.HandleError(err)
+	}
+	return v.toInternal(), nil
+}
+
+func (p *Persister) ListSchemaVersions(ctx context.Context) (_ []*namespace.SchemaVersion, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListSchemaVersions")
+	defer otelx.End(span, &err)
+
+	var vv SchemaVersions
+	if err := p.queryWithNetwork(ctx).Order("version DESC").All(&vv); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	res := make([]*namespace.SchemaVersion, len(vv))
+	for i, v := range vv {
+		res[i] = v.toInternal()
+	}
+	return res, nil
+}
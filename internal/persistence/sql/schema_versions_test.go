@@ -0,0 +1,59 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ory/herodot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/x/dbx"
+)
+
+func TestSchemaVersions(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range dbx.GetDSNs(t, false) {
+		dsn := dsn
+		t.Run("dsn="+dsn.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			reg := driver.NewTestRegistry(t, dsn)
+			require.NoError(t, reg.MigrateUp(ctx))
+			p := reg.Persister()
+
+			_, err := p.GetSchemaVersion(ctx, 0)
+			assert.ErrorIs(t, err, herodot.ErrNotFound)
+
+			v1, err := p.AddSchemaVersion(ctx, "class User implements Namespace {}")
+			require.NoError(t, err)
+			assert.Equal(t, 1, v1.Version)
+			v2, err := p.AddSchemaVersion(ctx, "class Group implements Namespace {}")
+			require.NoError(t, err)
+			assert.Equal(t, 2, v2.Version)
+
+			latest, err := p.GetSchemaVersion(ctx, 0)
+			require.NoError(t, err)
+			assert.Equal(t, v2.Version, latest.Version)
+			assert.Equal(t, v2.Content, latest.Content)
+
+			first, err := p.GetSchemaVersion(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, v1.Content, first.Content)
+
+			_, err = p.GetSchemaVersion(ctx, 3)
+			assert.ErrorIs(t, err, herodot.ErrNotFound)
+
+			all, err := p.ListSchemaVersions(ctx)
+			require.NoError(t, err)
+			require.Len(t, all, 2)
+			assert.Equal(t, 2, all[0].Version)
+			assert.Equal(t, 1, all[1].Version)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/relationtuple"
+)
+
+var _ relationtuple.SnaptokenManager = (*Persister)(nil)
+
+// Snaptoken returns the changelog cursor of the latest committed write on the
+// primary database.
+func (p *Persister) Snaptoken(ctx context.Context) (_ string, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.Snaptoken")
+	defer otelx.End(span, &err)
+
+	return p.LatestChangelogCursor(ctx)
+}
+
+// SnaptokenReplicated compares the snaptoken with the changelog sequence of
+// the read replica.
+func (p *Persister) SnaptokenReplicated(ctx context.Context, snaptoken string) (_ bool, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.SnaptokenReplicated")
+	defer otelx.End(span, &err)
+
+	seq, err := parseChangelogCursor(snaptoken)
+	if err != nil {
+		return false, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Malformed snaptoken %q.", snaptoken))
+	}
+	if p.readConn == p.conn {
+		return true, nil
+	}
+
+	var replicated changeSequence
+	err = p.readConn.WithContext(ctx).Where("nid = ?", p.NetworkID(ctx)).First(&replicated)
+	if errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+		return seq == 0, nil
+	} else if err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	return replicated.Sequence >= seq, nil
+}
+
+// TransactRelationTuplesWithSnaptoken applies the deltas like
+// TransactRelationTuples, and returns the snaptoken of the changelog
+// transaction that applied them.
+func (p *Persister) TransactRelationTuplesWithSnaptoken(ctx context.Context, ins []*relationtuple.RelationTuple, del []*relationtuple.RelationTuple, preconditions ...*relationtuple.Precondition) (_ string, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.TransactRelationTuplesWithSnaptoken")
+	defer otelx.End(span, &err)
+
+	seq, err := p.transactRelationTuples(ctx, ins, del, preconditions)
+	if err != nil {
+		return "", err
+	}
+	return formatChangelogCursor(seq), nil
+}
@@ -38,17 +38,38 @@ func (p *Persister) batchToUUIDs(ctx context.Context, values []string) (uuids []
 	}
 
 	uuids = make([]uuid.UUID, len(values))
+	mappings := make(map[string]uuid.UUID, len(values))
+	for i, val := range values {
+		uuids[i] = uuid.NewV5(p.NetworkID(ctx), val)
+		mappings[val] = uuids[i]
+	}
+	unique := maps.Keys(mappings)
+
+	p.d.Logger().WithField("values", values).WithField("UUIDs", uuids).Trace("adding UUID mappings")
+
+	// Large batches are split to stay below the parameter limit.
+	perStatement := maxInsertParameters / 2
+	for i := 0; i < len(unique); i += perStatement {
+		end := i + perStatement
+		if end > len(unique) {
+			end = len(unique)
+		}
+		if err := p.insertUUIDMappings(ctx, unique[i:end], mappings); err != nil {
+			return nil, err
+		}
+	}
+	return uuids, nil
+}
+
+func (p *Persister) insertUUIDMappings(ctx context.Context, values []string, mappings map[string]uuid.UUID) error {
 	placeholderArray := make([]string, len(values))
 	args := make([]interface{}, 0, len(values)*2)
 	for i, val := range values {
-		uuids[i] = uuid.NewV5(p.NetworkID(ctx), val)
 		placeholderArray[i] = "(?, ?)"
-		args = append(args, uuids[i], val)
+		args = append(args, mappings[val], val)
 	}
 	placeholders := strings.Join(placeholderArray, ", ")
 
-	p.d.Logger().WithField("values", values).WithField("UUIDs", uuids).Trace("adding UUID mappings")
-
 	// We need to write manual SQL here because the INSERT should not fail if
 	// the UUID already exists, but we still want to return an error if anything
 	// else goes wrong.
@@ -64,7 +85,7 @@ func (p *Persister) batchToUUIDs(ctx context.Context, values []string) (uuids []
 			ON CONFLICT (id) DO NOTHING`
 	}
 
-	return uuids, sqlcon.HandleError(
+	return sqlcon.HandleError(
 		p.Connection(ctx).RawQuery(query, args...).Exec(),
 	)
 }
@@ -101,7 +122,7 @@ func (p *Persister) batchFromUUIDs(ctx context.Context, ids []uuid.UUID, opts ..
 		}
 		idsToLookup := uniqueIDs[i:end]
 		var mappings []UUIDMapping
-		query := p.Connection(ctx).Where("id in (?)", idsToLookup)
+		query := p.readConnection(ctx).Where("id in (?)", idsToLookup)
 		if err := sqlcon.HandleError(query.All(&mappings)); err != nil {
 			return []string{}, err
 		}
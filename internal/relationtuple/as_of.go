@@ -0,0 +1,53 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ory/keto/internal/driver/config"
+)
+
+type asOfKey struct{}
+
+var ErrHistoryDisabled = herodot.ErrBadRequest.WithReason("Reading relationships as of a time requires the history mode, see the history.enabled config.")
+
+// WithAsOf returns a context in which relationships are read as they were at
+// the given time, which must be within the history retention. A nil time
+// returns the context unchanged.
+func WithAsOf(ctx context.Context, d config.Provider, asOf *time.Time) (context.Context, error) {
+	if asOf == nil {
+		return ctx, nil
+	}
+	if !d.Config(ctx).HistoryEnabled() {
+		return nil, errors.WithStack(ErrHistoryDisabled)
+	}
+	if retention := d.Config(ctx).HistoryRetention(); asOf.Before(time.Now().Add(-retention)) {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Relationships can only be read as of the last %s, see the history.retention config.", retention))
+	}
+	return context.WithValue(ctx, asOfKey{}, asOf.UTC()), nil
+}
+
+// AsOfFromProto converts the optional as_of field of a request.
+func AsOfFromProto(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithErrorf("invalid as_of timestamp: %s", err))
+	}
+	t := ts.AsTime()
+	return &t, nil
+}
+
+// AsOf returns the time as of which relationships are read, if any.
+func AsOf(ctx context.Context) (time.Time, bool) {
+	t, ok := ctx.Value(asOfKey{}).(time.Time)
+	return t, ok
+}
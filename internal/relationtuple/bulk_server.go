@@ -0,0 +1,130 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"io"
+
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+var _ rts.BulkServiceServer = (*handler)(nil)
+
+const (
+	// importBatchSize is the number of relationships that are mapped and
+	// written in one transaction.
+	importBatchSize = 1000
+	// defaultExportPageSize is the page size of exports that do not set one.
+	defaultExportPageSize = 1000
+)
+
+func (h *handler) ImportRelationTuples(stream rts.BulkService_ImportRelationTuplesServer) error {
+	ctx := stream.Context()
+
+	var (
+		batch    []*ketoapi.RelationTuple
+		imported int64
+		warnings []string
+		warned   = make(map[string]bool)
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		its, err := h.d.Mapper().FromTuple(ctx, batch...)
+		if err != nil {
+			return err
+		}
+		n, err := h.d.RelationTupleImporter().ImportRelationTuples(ctx, its...)
+		if err != nil {
+			return err
+		}
+		imported += int64(n)
+		for _, w := range h.deprecationWarnings(ctx, batch) {
+			if !warned[w] {
+				warned[w] = true
+				warnings = append(warnings, w)
+			}
+		}
+		batch = nil
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		for _, rt := range req.RelationTuples {
+			t, err := (&ketoapi.RelationTuple{}).FromDataProvider(rt)
+			if err != nil {
+				return err
+			}
+			batch = append(batch, t)
+			if len(batch) >= importBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	setDeprecationTrailer(ctx, warnings)
+	return stream.SendAndClose(&rts.ImportRelationTuplesResponse{Imported: imported})
+}
+
+func (h *handler) ExportRelationTuples(req *rts.ExportRelationTuplesRequest, stream rts.BulkService_ExportRelationTuplesServer) error {
+	ctx := stream.Context()
+
+	if req.Namespace == "" {
+		return errors.WithStack(herodot.ErrBadRequest.WithReason("The namespace to export is required."))
+	}
+	query, err := h.d.Mapper().FromQuery(ctx, &ketoapi.RelationQuery{Namespace: &req.Namespace})
+	if err != nil {
+		return err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultExportPageSize
+	}
+
+	cursor := req.Cursor
+	for {
+		rels, next, err := h.d.RelationTupleManager().GetRelationTuples(ctx, query, x.WithSize(pageSize), x.WithToken(cursor))
+		if err != nil {
+			return err
+		}
+		mapped, err := h.d.Mapper().ToTuple(ctx, rels...)
+		if err != nil {
+			return err
+		}
+
+		resp := &rts.ExportRelationTuplesResponse{
+			RelationTuples: make([]*rts.RelationTuple, len(mapped)),
+			Cursor:         next,
+		}
+		for i, r := range mapped {
+			resp.RelationTuples[i] = r.ToProto()
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
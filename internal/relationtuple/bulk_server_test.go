@@ -0,0 +1,147 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func TestBulkImportExport(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "a"}, {Name: "b"}}))
+
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(herodot.StreamErrorUnwrapInterceptor),
+	)
+	relationtuple.NewHandler(reg).RegisterWriteGRPC(s)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			t.Logf("Server exited with error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := rts.NewBulkServiceClient(conn)
+
+	// more than one batch of the import
+	tuples := make([]*ketoapi.RelationTuple, 2500)
+	for i := range tuples {
+		tuples[i] = &ketoapi.RelationTuple{
+			Namespace: "a",
+			Object:    fmt.Sprintf("o%d", i),
+			Relation:  "r",
+			SubjectID: pointerx.Ptr("s"),
+		}
+	}
+	tuples[0].SubjectID = nil
+	tuples[0].SubjectSet = &ketoapi.SubjectSet{Namespace: "b", Object: "o", Relation: "r"}
+
+	export := func(t *testing.T, req *rts.ExportRelationTuplesRequest) (exported []*ketoapi.RelationTuple, cursors []string) {
+		stream, err := client.ExportRelationTuples(ctx, req)
+		require.NoError(t, err)
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			require.NoError(t, err)
+			for _, rt := range resp.RelationTuples {
+				e, err := (&ketoapi.RelationTuple{}).FromDataProvider(rt)
+				require.NoError(t, err)
+				exported = append(exported, e)
+			}
+			cursors = append(cursors, resp.Cursor)
+		}
+	}
+
+	t.Run("case=imports in batches", func(t *testing.T) {
+		stream, err := client.ImportRelationTuples(ctx)
+		require.NoError(t, err)
+		for i := 0; i < len(tuples); i += 100 {
+			req := &rts.ImportRelationTuplesRequest{}
+			for _, rt := range tuples[i : i+100] {
+				req.RelationTuples = append(req.RelationTuples, rt.ToProto())
+			}
+			require.NoError(t, stream.Send(req))
+		}
+		resp, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		assert.EqualValues(t, len(tuples), resp.Imported)
+
+		entries, err := reg.RelationTupleChangelog().GetRelationTupleChanges(ctx, "", 10000)
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+	})
+
+	t.Run("case=counts only new relationships", func(t *testing.T) {
+		stream, err := client.ImportRelationTuples(ctx)
+		require.NoError(t, err)
+		req := &rts.ImportRelationTuplesRequest{}
+		for _, rt := range tuples[:10] {
+			req.RelationTuples = append(req.RelationTuples, rt.ToProto())
+		}
+		require.NoError(t, stream.Send(req))
+		resp, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		assert.Zero(t, resp.Imported)
+	})
+
+	t.Run("case=exports all tuples of the namespace", func(t *testing.T) {
+		exported, cursors := export(t, &rts.ExportRelationTuplesRequest{Namespace: "a", PageSize: 1000})
+		assert.ElementsMatch(t, tuples, exported)
+		require.Len(t, cursors, 3)
+		assert.Empty(t, cursors[2])
+
+		exported, _ = export(t, &rts.ExportRelationTuplesRequest{Namespace: "b"})
+		assert.Empty(t, exported)
+	})
+
+	t.Run("case=resumes the export after the cursor", func(t *testing.T) {
+		first, cursors := export(t, &rts.ExportRelationTuplesRequest{Namespace: "a", PageSize: 1000})
+		rest, _ := export(t, &rts.ExportRelationTuplesRequest{Namespace: "a", PageSize: 1000, Cursor: cursors[0]})
+		assert.ElementsMatch(t, first[1000:], rest)
+	})
+
+	t.Run("case=rejects invalid requests", func(t *testing.T) {
+		stream, err := client.ExportRelationTuples(ctx, &rts.ExportRelationTuplesRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		imp, err := client.ImportRelationTuples(ctx)
+		require.NoError(t, err)
+		require.NoError(t, imp.Send(&rts.ImportRelationTuplesRequest{RelationTuples: []*rts.RelationTuple{
+			(&ketoapi.RelationTuple{Namespace: "unknown", Object: "o", Relation: "r", SubjectID: pointerx.Ptr("s")}).ToProto(),
+		}}))
+		_, err = imp.CloseAndRecv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
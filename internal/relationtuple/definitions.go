@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 
@@ -24,7 +25,89 @@ type (
 		WriteRelationTuples(ctx context.Context, rs ...*RelationTuple) error
 		DeleteRelationTuples(ctx context.Context, rs ...*RelationTuple) error
 		DeleteAllRelationTuples(ctx context.Context, query *RelationQuery) error
-		TransactRelationTuples(ctx context.Context, insert []*RelationTuple, delete []*RelationTuple) error
+		// TransactRelationTuples applies the inserts and deletes in one
+		// transaction, if all preconditions hold in that transaction.
+		TransactRelationTuples(ctx context.Context, insert []*RelationTuple, delete []*RelationTuple, preconditions ...*Precondition) error
+	}
+	ImporterProvider interface {
+		RelationTupleImporter() Importer
+	}
+	// Importer writes large amounts of relationships.
+	Importer interface {
+		// ImportRelationTuples writes the relationships in one transaction,
+		// and returns how many of them did not exist yet. Callers split large
+		// imports into batches.
+		ImportRelationTuples(ctx context.Context, rs ...*RelationTuple) (int, error)
+	}
+	ObjectManagerProvider interface {
+		ObjectManager() ObjectManager
+	}
+	// ObjectManager changes all relationships that reference an object: the
+	// relationships of the object, and the relationships with a subject set
+	// of the object.
+	ObjectManager interface {
+		// DeleteObject deletes the relationships that reference the object in
+		// one transaction, and returns their number.
+		DeleteObject(ctx context.Context, namespace string, object uuid.UUID) (int, error)
+		// RenameObject moves the relationships that reference the object to
+		// the new object in one transaction, and returns their number. The new
+		// object must not be referenced yet.
+		RenameObject(ctx context.Context, namespace string, object, newObject uuid.UUID) (int, error)
+	}
+	ChangelogProvider interface {
+		RelationTupleChangelog() Changelog
+	}
+	// Changelog is the log of all inserts and deletes of relationships. It is
+	// written in the same transaction as the relationships, so it has the
+	// changes in commit order.
+	Changelog interface {
+		// GetRelationTupleChanges returns at most about limit changes of the
+		// transactions that were committed after the cursor, oldest first.
+		// Transactions are never split. The empty cursor starts at the
+		// beginning of the log.
+		GetRelationTupleChanges(ctx context.Context, cursor string, limit int) ([]*ChangelogEntry, error)
+		// LatestChangelogCursor returns the cursor of the latest committed
+		// transaction.
+		LatestChangelogCursor(ctx context.Context) (string, error)
+		// PruneRelationTupleChanges deletes the changes that were committed
+		// before the given time, and returns how many were deleted. Reading
+		// from a cursor before the deleted changes fails afterwards.
+		PruneRelationTupleChanges(ctx context.Context, before time.Time) (int, error)
+	}
+	SnaptokenManagerProvider interface {
+		SnaptokenManager() SnaptokenManager
+	}
+	// SnaptokenManager issues snaptokens, which identify the writes committed
+	// so far, and tells whether the read replica has replicated them.
+	SnaptokenManager interface {
+		// Snaptoken returns the snaptoken of the latest committed write.
+		Snaptoken(ctx context.Context) (string, error)
+		// SnaptokenReplicated returns whether reads from the read replica
+		// observe all writes up to the snaptoken. It is always true without
+		// a read replica. Malformed snaptokens are a bad request.
+		SnaptokenReplicated(ctx context.Context, snaptoken string) (bool, error)
+		// TransactRelationTuplesWithSnaptoken applies the deltas like
+		// Manager.TransactRelationTuples, and returns the snaptoken of the
+		// transaction that applied them.
+		TransactRelationTuplesWithSnaptoken(ctx context.Context, insert []*RelationTuple, delete []*RelationTuple, preconditions ...*Precondition) (string, error)
+	}
+	// ChangelogEntry are the changes of one transaction.
+	ChangelogEntry struct {
+		// Cursor points after this transaction.
+		Cursor     string
+		CommitTime time.Time
+		Changes    []*Change
+	}
+	Change struct {
+		Action        ketoapi.PatchAction
+		RelationTuple *RelationTuple
+	}
+	// Precondition is a condition on the stored relationships that must hold
+	// for a transaction to be applied. Exactly one of the fields is set.
+	Precondition struct {
+		TupleExists    *RelationTuple
+		TupleNotExists *RelationTuple
+		NoMatch        *RelationQuery
 	}
 	SubjectID struct {
 		ID uuid.UUID `json:"id"`
@@ -59,6 +142,18 @@ type (
 		Relation  string    `json:"relation"`
 	}
 
+	// SubjectTypeCount is the number of stored relationships of one namespace
+	// and relation that have the same type of subject.
+	SubjectTypeCount struct {
+		Namespace string `json:"namespace" db:"namespace"`
+		Relation  string `json:"relation" db:"relation"`
+		// SubjectSetNamespace and SubjectSetRelation are empty for subject
+		// IDs.
+		SubjectSetNamespace string `json:"subject_set_namespace,omitempty" db:"subject_set_namespace"`
+		SubjectSetRelation  string `json:"subject_set_relation,omitempty" db:"subject_set_relation"`
+		Count               int    `json:"count" db:"count"`
+	}
+
 	// TODO(hperl): Also use a ketoapi.Tree here.
 	Tree struct {
 		Type     ketoapi.TreeNodeType `json:"type"`
@@ -98,6 +193,10 @@ func (s *SubjectSet) String() string {
 	return fmt.Sprintf("%s:%s#%s", s.Namespace, s.Object, s.Relation)
 }
 
+func (c *SubjectTypeCount) IsSubjectID() bool {
+	return c.SubjectSetNamespace == ""
+}
+
 func (t *RelationTuple) ToQuery() *RelationQuery {
 	return &RelationQuery{
 		Namespace: &t.Namespace,
@@ -163,8 +262,8 @@ func (t *ManagerWrapper) DeleteAllRelationTuples(ctx context.Context, query *Rel
 	return t.Reg.RelationTupleManager().DeleteAllRelationTuples(ctx, query)
 }
 
-func (t *ManagerWrapper) TransactRelationTuples(ctx context.Context, insert []*RelationTuple, delete []*RelationTuple) error {
-	return t.Reg.RelationTupleManager().TransactRelationTuples(ctx, insert, delete)
+func (t *ManagerWrapper) TransactRelationTuples(ctx context.Context, insert []*RelationTuple, delete []*RelationTuple, preconditions ...*Precondition) error {
+	return t.Reg.RelationTupleManager().TransactRelationTuples(ctx, insert, delete, preconditions...)
 }
 
 func (t *ManagerWrapper) RelationTupleManager() Manager {
@@ -0,0 +1,97 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ory/keto/internal/namespace/ast"
+	"github.com/ory/keto/ketoapi"
+)
+
+// DeprecationTrailer is the gRPC trailer that has a value for every
+// deprecated relation that a write created relationships in. The REST API uses
+// the "Warning" header instead.
+const DeprecationTrailer = "keto-deprecation-warning"
+
+var deprecatedWrites = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "keto",
+	Name:      "deprecated_relation_writes_total",
+	Help:      "Number of relationships written to relations that are marked as @deprecated in the namespace configuration.",
+}, []string{"namespace", "relation"})
+
+// deprecationWarnings returns one warning for every deprecated relation of the
+// relationships, and counts the relationships in the deprecated writes metric.
+// Unknown namespaces and relations are ignored, as they are not deprecated.
+func (h *handler) deprecationWarnings(ctx context.Context, tuples []*ketoapi.RelationTuple) (warnings []string) {
+	nm, err := h.d.Config(ctx).NamespaceManager()
+	if err != nil {
+		return nil
+	}
+
+	warned := make(map[[2]string]bool)
+	for _, t := range tuples {
+		n, err := nm.GetNamespaceByName(ctx, t.Namespace)
+		if err != nil {
+			continue
+		}
+		relation, ok := findRelation(n.Relations, t.Relation)
+		if !ok || !relation.Deprecated {
+			continue
+		}
+
+		deprecatedWrites.WithLabelValues(t.Namespace, t.Relation).Inc()
+		if key := [2]string{t.Namespace, t.Relation}; !warned[key] {
+			warned[key] = true
+			warnings = append(warnings, deprecationWarning(t.Namespace, relation))
+		}
+	}
+	return warnings
+}
+
+func findRelation(relations []ast.Relation, name string) (ast.Relation, bool) {
+	for _, r := range relations {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return ast.Relation{}, false
+}
+
+func deprecationWarning(namespace string, relation ast.Relation) string {
+	msg := fmt.Sprintf("%s#%s is deprecated", namespace, relation.Name)
+	if relation.DeprecationReason != "" {
+		msg += ": " + relation.DeprecationReason
+	}
+	return msg
+}
+
+var warnTextEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// setDeprecationHeaders adds the warnings as "Warning" headers with the
+// miscellaneous persistent warning code 299 (RFC 7234, section 5.5).
+func setDeprecationHeaders(w http.ResponseWriter, warnings []string) {
+	for _, warning := range warnings {
+		w.Header().Add("Warning", `299 - "`+warnTextEscaper.Replace(warning)+`"`)
+	}
+}
+
+// setDeprecationTrailer sets the warnings as the deprecation trailer of the
+// gRPC call.
+func setDeprecationTrailer(ctx context.Context, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	// The trailer can only fail to be set outside of a gRPC call, e.g. in
+	// tests that call the handler directly.
+	_ = grpc.SetTrailer(ctx, metadata.MD{DeprecationTrailer: warnings})
+}
@@ -8,6 +8,7 @@ import (
 
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 
+	"github.com/ory/keto/internal/driver/config"
 	"github.com/ory/keto/internal/x"
 )
 
@@ -15,6 +16,11 @@ type (
 	handlerDeps interface {
 		ManagerProvider
 		MapperProvider
+		ChangelogProvider
+		ImporterProvider
+		ObjectManagerProvider
+		SnaptokenManagerProvider
+		config.Provider
 		x.LoggerProvider
 		x.WriterProvider
 	}
@@ -36,18 +42,23 @@ func NewHandler(d handlerDeps) *handler {
 
 func (h *handler) RegisterReadRoutes(r *x.ReadRouter) {
 	r.GET(ReadRouteBase, h.getRelations)
+	r.GET(WatchRouteBase, h.watchRelations)
 }
 
 func (h *handler) RegisterWriteRoutes(r *x.WriteRouter) {
 	r.PUT(WriteRouteBase, h.createRelation)
 	r.DELETE(WriteRouteBase, h.deleteRelations)
 	r.PATCH(WriteRouteBase, h.patchRelationTuples)
+	r.DELETE(ObjectsRouteBase, h.deleteObjectRoute)
+	r.POST(RenameObjectRoute, h.renameObjectRoute)
 }
 
 func (h *handler) RegisterReadGRPC(s *grpc.Server) {
 	rts.RegisterReadServiceServer(s, h)
+	rts.RegisterWatchServiceServer(s, h)
 }
 
 func (h *handler) RegisterWriteGRPC(s *grpc.Server) {
 	rts.RegisterWriteServiceServer(s, h)
+	rts.RegisterBulkServiceServer(s, h)
 }
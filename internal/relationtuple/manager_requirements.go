@@ -445,5 +445,59 @@ func ManagerTest(t *testing.T, m Manager) {
 			require.NoError(t, err)
 			assert.Equal(t, []*RelationTuple{rs[0]}, res)
 		})
+
+		t.Run("case=preconditions", func(t *testing.T) {
+			nspace := strconv.Itoa(rand.Int()) // nolint
+			obj := uuid.Must(uuid.NewV4())
+			owner := func(id uuid.UUID) *RelationTuple {
+				return &RelationTuple{
+					Namespace: nspace,
+					Object:    obj,
+					Relation:  "owner",
+					Subject:   &SubjectID{ID: id},
+				}
+			}
+			alice, bob := owner(uuid.Must(uuid.NewV4())), owner(uuid.Must(uuid.NewV4()))
+			require.NoError(t, m.WriteRelationTuples(ctx, alice))
+
+			owners := func(t *testing.T) []*RelationTuple {
+				res, _, err := m.GetRelationTuples(ctx, &RelationQuery{Namespace: &nspace})
+				require.NoError(t, err)
+				return res
+			}
+
+			for _, tc := range []struct {
+				name          string
+				preconditions []*Precondition
+			}{
+				{
+					name:          "tuple exists",
+					preconditions: []*Precondition{{TupleExists: bob}},
+				},
+				{
+					name:          "tuple not exists",
+					preconditions: []*Precondition{{TupleExists: alice}, {TupleNotExists: alice}},
+				},
+				{
+					name:          "no match",
+					preconditions: []*Precondition{{NoMatch: &RelationQuery{Namespace: &nspace, Object: &obj}}},
+				},
+			} {
+				t.Run("violated="+tc.name, func(t *testing.T) {
+					err := m.TransactRelationTuples(ctx, []*RelationTuple{bob}, []*RelationTuple{alice}, tc.preconditions...)
+					assert.ErrorIs(t, err, ErrPreconditionFailed)
+					assert.Equal(t, []*RelationTuple{alice}, owners(t))
+				})
+			}
+
+			t.Run("case=transfers ownership", func(t *testing.T) {
+				require.NoError(t, m.TransactRelationTuples(ctx, []*RelationTuple{bob}, []*RelationTuple{alice},
+					&Precondition{TupleExists: alice},
+					&Precondition{TupleNotExists: bob},
+					&Precondition{NoMatch: &RelationQuery{Namespace: &nspace, Relation: pointerx.Ptr("editor")}},
+				))
+				assert.Equal(t, []*RelationTuple{bob}, owners(t))
+			})
+		})
 	})
 }
@@ -0,0 +1,158 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	ObjectsRouteBase  = WriteRouteBase + "/objects"
+	RenameObjectRoute = ObjectsRouteBase + "/rename"
+)
+
+func (h *handler) DeleteObject(ctx context.Context, req *rts.DeleteObjectRequest) (*rts.DeleteObjectResponse, error) {
+	deleted, err := h.deleteObject(ctx, req.Namespace, req.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &rts.DeleteObjectResponse{Deleted: int64(deleted)}, nil
+}
+
+func (h *handler) RenameObject(ctx context.Context, req *rts.RenameObjectRequest) (*rts.RenameObjectResponse, error) {
+	renamed, err := h.renameObject(ctx, &ketoapi.RenameObjectRequest{
+		Namespace: req.Namespace,
+		Object:    req.Object,
+		NewObject: req.NewObject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rts.RenameObjectResponse{Renamed: int64(renamed)}, nil
+}
+
+func (h *handler) deleteObject(ctx context.Context, namespace, object string) (int, error) {
+	if namespace == "" || object == "" {
+		return 0, errors.WithStack(herodot.ErrBadRequest.WithReason("The namespace and object are required."))
+	}
+	ids, err := h.d.Mapper().FromObjects(ctx, namespace, object)
+	if err != nil {
+		return 0, err
+	}
+	return h.d.ObjectManager().DeleteObject(ctx, namespace, ids[0])
+}
+
+func (h *handler) renameObject(ctx context.Context, req *ketoapi.RenameObjectRequest) (int, error) {
+	if req.Namespace == "" || req.Object == "" || req.NewObject == "" {
+		return 0, errors.WithStack(herodot.ErrBadRequest.WithReason("The namespace, object and new object are required."))
+	}
+	// Mapping the new object also stores its string representation.
+	ids, err := h.d.Mapper().FromObjects(ctx, req.Namespace, req.Object, req.NewObject)
+	if err != nil {
+		return 0, err
+	}
+	return h.d.ObjectManager().RenameObject(ctx, req.Namespace, ids[0], ids[1])
+}
+
+// Delete Object Request Parameters
+//
+// swagger:parameters deleteObject
+// nolint:deadcode,unused
+type deleteObject struct {
+	// Namespace of the object.
+	//
+	// in: query
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// The object to delete.
+	//
+	// in: query
+	// required: true
+	Object string `json:"object"`
+}
+
+// swagger:route DELETE /admin/relation-tuples/objects relationship deleteObject
+//
+// # Delete an Object
+//
+// Deletes all relationships of the object, and all relationships with a
+// subject set of the object, in one transaction.
+//
+//	Consumes:
+//	-  application/x-www-form-urlencoded
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: deleteObjectResult
+//	  400: errorGeneric
+//	  404: errorGeneric
+//	  default: errorGeneric
+func (h *handler) deleteObjectRoute(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	q := r.URL.Query()
+	deleted, err := h.deleteObject(r.Context(), q.Get("namespace"), q.Get("object"))
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	h.d.Writer().Write(w, r, &ketoapi.DeleteObjectResponse{Deleted: deleted})
+}
+
+// Rename Object Request Parameters
+//
+// swagger:parameters renameObject
+// nolint:deadcode,unused
+type renameObject struct {
+	// in: body
+	Body ketoapi.RenameObjectRequest
+}
+
+// swagger:route POST /admin/relation-tuples/objects/rename relationship renameObject
+//
+// # Rename an Object
+//
+// Moves all relationships of the object, and all relationships with a subject
+// set of the object, to the new object ID in one transaction. The new object
+// must not have relationships yet.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: renameObjectResult
+//	  400: errorGeneric
+//	  404: errorGeneric
+//	  409: errorGeneric
+//	  default: errorGeneric
+func (h *handler) renameObjectRoute(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var req ketoapi.RenameObjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+	renamed, err := h.renameObject(r.Context(), &req)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	h.d.Writer().Write(w, r, &ketoapi.RenameObjectResponse{Renamed: renamed})
+}
@@ -0,0 +1,122 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/ketoapi"
+)
+
+func TestObjectHandlers(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "doc"}, {Name: "group"}}))
+
+	ts := httptest.NewServer(reg.WriteRouter(ctx))
+	t.Cleanup(ts.Close)
+
+	tuples := func(t *testing.T) []*ketoapi.RelationTuple {
+		rs, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+		require.NoError(t, err)
+		mapped, err := reg.Mapper().ToTuple(ctx, rs...)
+		require.NoError(t, err)
+		return mapped
+	}
+	deleteObject := func(t *testing.T, namespace, object string) *http.Response {
+		req, err := http.NewRequest(http.MethodDelete,
+			ts.URL+relationtuple.ObjectsRouteBase+"?"+url.Values{"namespace": {namespace}, "object": {object}}.Encode(), nil)
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+	renameObject := func(t *testing.T, req *ketoapi.RenameObjectRequest) *http.Response {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := ts.Client().Post(ts.URL+relationtuple.RenameObjectRoute, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	owner := &ketoapi.RelationTuple{Namespace: "doc", Object: "a", Relation: "owner", SubjectID: pointerx.Ptr("alice")}
+	viewers := &ketoapi.RelationTuple{Namespace: "doc", Object: "a", Relation: "viewer", SubjectSet: &ketoapi.SubjectSet{Namespace: "group", Object: "eng", Relation: "member"}}
+	parent := &ketoapi.RelationTuple{Namespace: "doc", Object: "b", Relation: "parent", SubjectSet: &ketoapi.SubjectSet{Namespace: "doc", Object: "a", Relation: ""}}
+	unrelated := &ketoapi.RelationTuple{Namespace: "doc", Object: "c", Relation: "owner", SubjectID: pointerx.Ptr("bob")}
+	// same object ID in a different namespace
+	group := &ketoapi.RelationTuple{Namespace: "group", Object: "a", Relation: "member", SubjectID: pointerx.Ptr("alice")}
+	relationtuple.MapAndWriteTuples(t, reg, owner, viewers, parent, unrelated, group)
+
+	t.Run("case=rename object", func(t *testing.T) {
+		cursor, err := reg.RelationTupleChangelog().LatestChangelogCursor(ctx)
+		require.NoError(t, err)
+
+		resp := renameObject(t, &ketoapi.RenameObjectRequest{Namespace: "doc", Object: "a", NewObject: "z"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var res ketoapi.RenameObjectResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		assert.Equal(t, 3, res.Renamed)
+
+		assert.ElementsMatch(t, []*ketoapi.RelationTuple{
+			{Namespace: "doc", Object: "z", Relation: "owner", SubjectID: pointerx.Ptr("alice")},
+			{Namespace: "doc", Object: "z", Relation: "viewer", SubjectSet: viewers.SubjectSet},
+			{Namespace: "doc", Object: "b", Relation: "parent", SubjectSet: &ketoapi.SubjectSet{Namespace: "doc", Object: "z"}},
+			unrelated, group,
+		}, tuples(t))
+
+		// the relationships are rewritten in one logged transaction
+		entries, err := reg.RelationTupleChangelog().GetRelationTupleChanges(ctx, cursor, 100)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		actions := make(map[ketoapi.PatchAction]int)
+		for _, c := range entries[0].Changes {
+			actions[c.Action]++
+		}
+		assert.Equal(t, map[ketoapi.PatchAction]int{ketoapi.ActionDelete: 3, ketoapi.ActionInsert: 3}, actions)
+	})
+
+	t.Run("case=rename to referenced object", func(t *testing.T) {
+		resp := renameObject(t, &ketoapi.RenameObjectRequest{Namespace: "doc", Object: "z", NewObject: "c"})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Len(t, tuples(t), 5)
+	})
+
+	t.Run("case=rename requires all fields", func(t *testing.T) {
+		resp := renameObject(t, &ketoapi.RenameObjectRequest{Namespace: "doc", Object: "z"})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("case=unknown namespace", func(t *testing.T) {
+		resp := deleteObject(t, "unknown", "z")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("case=delete object", func(t *testing.T) {
+		resp := deleteObject(t, "doc", "z")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var res ketoapi.DeleteObjectResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		assert.Equal(t, 3, res.Deleted)
+		assert.ElementsMatch(t, []*ketoapi.RelationTuple{unrelated, group}, tuples(t))
+
+		resp = deleteObject(t, "doc", "z")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		assert.Equal(t, 0, res.Deleted)
+	})
+}
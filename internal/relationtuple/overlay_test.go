@@ -0,0 +1,90 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+)
+
+func TestOverlayManager(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "n"}}))
+
+	obj := uuid.Must(uuid.NewV4())
+	tuple := func(relation string) *relationtuple.RelationTuple {
+		return &relationtuple.RelationTuple{
+			Namespace: "n",
+			Object:    obj,
+			Relation:  relation,
+			Subject:   &relationtuple.SubjectID{ID: uuid.Must(uuid.NewV4())},
+		}
+	}
+	stored := []*relationtuple.RelationTuple{tuple("a"), tuple("a"), tuple("b"), tuple("b")}
+	require.NoError(t, reg.RelationTupleManager().WriteRelationTuples(ctx, stored...))
+
+	inserted := []*relationtuple.RelationTuple{tuple("a"), tuple("b"), stored[0]}
+	o := relationtuple.NewOverlayManager(reg.RelationTupleManager(), inserted, []*relationtuple.RelationTuple{stored[1], inserted[1]})
+
+	getAll := func(t *testing.T, query *relationtuple.RelationQuery) (res []*relationtuple.RelationTuple) {
+		var (
+			page     []*relationtuple.RelationTuple
+			nextPage = ""
+			err      error
+		)
+		for {
+			page, nextPage, err = o.GetRelationTuples(ctx, query, x.WithSize(1), x.WithToken(nextPage))
+			require.NoError(t, err)
+			res = append(res, page...)
+			if nextPage == "" {
+				return res
+			}
+		}
+	}
+
+	t.Run("case=applies the deltas", func(t *testing.T) {
+		assert.ElementsMatch(t,
+			[]*relationtuple.RelationTuple{stored[0], stored[2], stored[3], inserted[0]},
+			getAll(t, &relationtuple.RelationQuery{}))
+	})
+
+	t.Run("case=inserted relationships match the query", func(t *testing.T) {
+		assert.ElementsMatch(t,
+			[]*relationtuple.RelationTuple{stored[0], inserted[0]},
+			getAll(t, &relationtuple.RelationQuery{Relation: pointerx.Ptr("a")}))
+		assert.ElementsMatch(t,
+			[]*relationtuple.RelationTuple{stored[2], stored[3]},
+			getAll(t, &relationtuple.RelationQuery{Relation: pointerx.Ptr("b")}))
+		assert.Empty(t, getAll(t, &relationtuple.RelationQuery{Namespace: pointerx.Ptr("n"), Subject: inserted[1].Subject}))
+	})
+
+	t.Run("case=without underlying manager", func(t *testing.T) {
+		o := relationtuple.NewOverlayManager(nil, []*relationtuple.RelationTuple{stored[0], stored[2], stored[0]}, nil)
+		actual, nextPage, err := o.GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, nextPage)
+		assert.ElementsMatch(t, []*relationtuple.RelationTuple{stored[0], stored[2]}, actual)
+	})
+
+	t.Run("case=is read-only", func(t *testing.T) {
+		assert.ErrorIs(t, o.WriteRelationTuples(ctx, tuple("c")), relationtuple.ErrReadOnlyOverlay)
+		assert.ErrorIs(t, o.DeleteRelationTuples(ctx, stored[0]), relationtuple.ErrReadOnlyOverlay)
+		assert.ErrorIs(t, o.DeleteAllRelationTuples(ctx, &relationtuple.RelationQuery{}), relationtuple.ErrReadOnlyOverlay)
+		assert.ErrorIs(t, o.TransactRelationTuples(ctx, nil, nil), relationtuple.ErrReadOnlyOverlay)
+
+		actual, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, stored, actual)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+// ErrPreconditionFailed is returned if a precondition of a transaction does
+// not hold. No change of the transaction was applied.
+var ErrPreconditionFailed = herodot.ErrConflict.WithError("a precondition of the transaction does not hold")
+
+func preconditionsFromProto(ps []*rts.Precondition) ([]*ketoapi.Precondition, error) {
+	res := make([]*ketoapi.Precondition, len(ps))
+	for i, p := range ps {
+		res[i] = &ketoapi.Precondition{}
+		switch c := p.Condition.(type) {
+		case *rts.Precondition_TupleExists:
+			t, err := (&ketoapi.RelationTuple{}).FromDataProvider(c.TupleExists)
+			if err != nil {
+				return nil, err
+			}
+			res[i].TupleExists = t
+		case *rts.Precondition_TupleNotExists:
+			t, err := (&ketoapi.RelationTuple{}).FromDataProvider(c.TupleNotExists)
+			if err != nil {
+				return nil, err
+			}
+			res[i].TupleNotExists = t
+		case *rts.Precondition_NoMatch:
+			res[i].NoMatch = (&ketoapi.RelationQuery{}).FromDataProvider(&queryWrapper{c.NoMatch})
+		default:
+			return nil, errors.WithStack(ketoapi.ErrInvalidPrecondition)
+		}
+	}
+	return res, nil
+}
+
+// mapPreconditions validates the preconditions and maps them to UUIDs.
+func (h *handler) mapPreconditions(ctx context.Context, ps []*ketoapi.Precondition) ([]*Precondition, error) {
+	res := make([]*Precondition, len(ps))
+	for i, p := range ps {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+
+		res[i] = &Precondition{}
+		switch {
+		case p.TupleExists != nil:
+			its, err := h.d.Mapper().FromTuple(ctx, p.TupleExists)
+			if err != nil {
+				return nil, err
+			}
+			res[i].TupleExists = its[0]
+		case p.TupleNotExists != nil:
+			its, err := h.d.Mapper().FromTuple(ctx, p.TupleNotExists)
+			if err != nil {
+				return nil, err
+			}
+			res[i].TupleNotExists = its[0]
+		default:
+			iq, err := h.d.Mapper().FromQuery(ctx, p.NoMatch)
+			if err != nil {
+				return nil, err
+			}
+			res[i].NoMatch = iq
+		}
+	}
+	return res, nil
+}
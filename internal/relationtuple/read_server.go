@@ -79,6 +79,19 @@ func (h *handler) ListRelationTuples(ctx context.Context, req *rts.ListRelationT
 		return nil, herodot.ErrBadRequest.WithError("you must provide a query")
 	}
 
+	asOf, err := AsOfFromProto(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = WithAsOf(ctx, h.d, asOf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = WithSnaptoken(ctx, h.d, req.Snaptoken)
+	if err != nil {
+		return nil, err
+	}
+
 	iq, err := h.d.Mapper().FromQuery(ctx, &q)
 	if err != nil {
 		return nil, err
@@ -154,6 +167,22 @@ func (h *handler) getRelations(w http.ResponseWriter, r *http.Request, _ httprou
 		paginationOpts = append(paginationOpts, x.WithSize(int(s)))
 	}
 
+	asOf, err := x.GetAsOfFromQuery(q)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	ctx, err = WithAsOf(ctx, h.d, asOf)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	ctx, err = WithSnaptoken(ctx, h.d, q.Get("snaptoken"))
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+
 	iq, err := h.d.Mapper().FromQuery(ctx, query)
 	if err != nil {
 		h.d.Writer().WriteError(w, r, err)
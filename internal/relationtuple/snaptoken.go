@@ -0,0 +1,41 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+)
+
+type primaryKey struct{}
+
+// WithPrimary returns a context in which relationships are read from the
+// primary database instead of the read replica.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// ReadsPrimary returns whether relationships are read from the primary
+// database.
+func ReadsPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
+
+// WithSnaptoken returns a context in which reads observe at least the writes
+// up to the snaptoken. If the read replica has not replicated them yet, the
+// relationships are read from the primary database. An empty snaptoken
+// returns the context unchanged.
+func WithSnaptoken(ctx context.Context, d SnaptokenManagerProvider, snaptoken string) (context.Context, error) {
+	if snaptoken == "" || ReadsPrimary(ctx) {
+		return ctx, nil
+	}
+	replicated, err := d.SnaptokenManager().SnaptokenReplicated(ctx, snaptoken)
+	if err != nil {
+		return nil, err
+	}
+	if !replicated {
+		return WithPrimary(ctx), nil
+	}
+	return ctx, nil
+}
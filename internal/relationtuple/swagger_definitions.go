@@ -4,6 +4,8 @@
 package relationtuple
 
 import (
+	"time"
+
 	"github.com/ory/keto/internal/x"
 	"github.com/ory/keto/ketoapi"
 )
@@ -65,6 +67,18 @@ type getRelationships struct {
 	// Either subject_set.* or subject_id are required.
 	SRelation string `json:"subject_set.relation"`
 
+	// Lists the relationships as they were at this time, in RFC 3339 format.
+	// Requires the history mode, and a time within its retention.
+	//
+	// in: query
+	AsOf *time.Time `json:"as_of"`
+
+	// Lists relationships no older than this snaptoken, reading from the
+	// primary database if the read replica lags behind.
+	//
+	// in: query
+	Snaptoken string `json:"snaptoken"`
+
 	// swagger:allOf
 	x.PaginationOptions
 }
@@ -4,6 +4,7 @@
 package relationtuple
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -45,19 +46,29 @@ func (h *handler) TransactRelationTuples(ctx context.Context, req *rts.TransactR
 		return nil, err
 	}
 
+	preconditions, err := preconditionsFromProto(req.Preconditions)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := h.mapPreconditions(ctx, preconditions)
+	if err != nil {
+		return nil, err
+	}
+
 	its, err := h.d.Mapper().FromTuple(ctx, append(insertTuples, deleteTuples...)...)
 	if err != nil {
 		return nil, err
 	}
 
-	err = h.d.RelationTupleManager().TransactRelationTuples(ctx, its[:len(insertTuples)], its[len(insertTuples):])
+	snaptoken, err := h.d.SnaptokenManager().TransactRelationTuplesWithSnaptoken(ctx, its[:len(insertTuples)], its[len(insertTuples):], ips...)
 	if err != nil {
 		return nil, err
 	}
+	setDeprecationTrailer(ctx, h.deprecationWarnings(ctx, insertTuples))
 
 	snaptokens := make([]string, len(insertTuples))
 	for i := range insertTuples {
-		snaptokens[i] = "not yet implemented"
+		snaptokens[i] = snaptoken
 	}
 	return &rts.TransactRelationTuplesResponse{
 		Snaptokens: snaptokens,
@@ -148,6 +159,7 @@ func (h *handler) createRelation(w http.ResponseWriter, r *http.Request, _ httpr
 		return
 	}
 
+	setDeprecationHeaders(w, h.deprecationWarnings(ctx, []*ketoapi.RelationTuple{&rt}))
 	h.d.Writer().WriteCreated(w, r,
 		ReadRouteBase+"?"+rt.ToURLQuery().Encode(),
 		&rt,
@@ -218,6 +230,11 @@ func internalTuplesWithAction(deltas []*ketoapi.PatchDelta, action ketoapi.Patch
 //
 // Use this endpoint to patch one or more relationships.
 //
+// The body is either a list of changes, or a relationshipPatchWithPreconditions
+// object with the changes and preconditions. The preconditions are checked in
+// the transaction that applies the changes. If any of them does not hold, no
+// change is applied and the endpoint responds with 409.
+//
 //	Consumes:
 //	- application/json
 //
@@ -230,15 +247,27 @@ func internalTuplesWithAction(deltas []*ketoapi.PatchDelta, action ketoapi.Patch
 //	  204: emptyResponse
 //	  400: errorGeneric
 //	  404: errorGeneric
+//	  409: errorGeneric
 //	  default: errorGeneric
 func (h *handler) patchRelationTuples(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	ctx := r.Context()
 
-	var deltas []*ketoapi.PatchDelta
-	if err := json.NewDecoder(r.Body).Decode(&deltas); err != nil {
+	var body json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		h.d.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()))
 		return
 	}
+	var req ketoapi.PatchRequest
+	if bytes.HasPrefix(body, []byte("{")) {
+		if err := json.Unmarshal(body, &req); err != nil {
+			h.d.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()))
+			return
+		}
+	} else if err := json.Unmarshal(body, &req.Deltas); err != nil {
+		h.d.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()))
+		return
+	}
+	deltas := req.Deltas
 	for _, d := range deltas {
 		if d.RelationTuple == nil {
 			h.d.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError("relation_tuple is missing"))
@@ -260,6 +289,11 @@ func (h *handler) patchRelationTuples(w http.ResponseWriter, r *http.Request, _
 	insertTuples := internalTuplesWithAction(deltas, ketoapi.ActionInsert)
 	deleteTuples := internalTuplesWithAction(deltas, ketoapi.ActionDelete)
 
+	ips, err := h.mapPreconditions(ctx, req.Preconditions)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
 	its, err := h.d.Mapper().FromTuple(ctx, append(insertTuples, deleteTuples...)...)
 	if err != nil {
 		h.d.Logger().WithError(err).Errorf("got an error while mapping fields to UUID")
@@ -270,11 +304,13 @@ func (h *handler) patchRelationTuples(w http.ResponseWriter, r *http.Request, _
 		TransactRelationTuples(
 			ctx,
 			its[:len(insertTuples)],
-			its[len(insertTuples):]); err != nil {
+			its[len(insertTuples):],
+			ips...); err != nil {
 
 		h.d.Writer().WriteError(w, r, err)
 		return
 	}
 
+	setDeprecationHeaders(w, h.deprecationWarnings(ctx, insertTuples))
 	w.WriteHeader(http.StatusNoContent)
 }
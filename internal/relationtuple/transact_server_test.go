@@ -8,12 +8,21 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/ory/herodot"
 	"github.com/ory/x/pointerx"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/ory/keto/ketoapi"
 
@@ -27,7 +36,9 @@ import (
 	"github.com/ory/keto/internal/driver"
 	"github.com/ory/keto/internal/namespace"
 	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/schema"
 	"github.com/ory/keto/internal/x"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
 
 func TestWriteHandlers(t *testing.T) {
@@ -388,6 +399,53 @@ func TestWriteHandlers(t *testing.T) {
 			assert.Contains(t, string(errContent), "relation_tuple is missing")
 		})
 
+		t.Run("case=preconditions", func(t *testing.T) {
+			nspace := addNamespace(t)
+			owner := func(id string) *ketoapi.RelationTuple {
+				return &ketoapi.RelationTuple{Namespace: nspace.Name, Object: "doc", Relation: "owner", SubjectID: pointerx.Ptr(id)}
+			}
+			relationtuple.MapAndWriteTuples(t, reg, owner("alice"))
+
+			transfer := func(t *testing.T, from string) *http.Response {
+				body, err := json.Marshal(&ketoapi.PatchRequest{
+					Deltas: []*ketoapi.PatchDelta{
+						{Action: ketoapi.ActionDelete, RelationTuple: owner(from)},
+						{Action: ketoapi.ActionInsert, RelationTuple: owner("bob")},
+					},
+					Preconditions: []*ketoapi.Precondition{{TupleExists: owner(from)}},
+				})
+				require.NoError(t, err)
+				req, err := http.NewRequest(http.MethodPatch, ts.URL+relationtuple.WriteRouteBase, bytes.NewBuffer(body))
+				require.NoError(t, err)
+				resp, err := ts.Client().Do(req)
+				require.NoError(t, err)
+				return resp
+			}
+			owners := func(t *testing.T) []*ketoapi.RelationTuple {
+				actualRTs, _, err := reg.RelationTupleManager().GetRelationTuples(ctx, &relationtuple.RelationQuery{Namespace: &nspace.Name})
+				require.NoError(t, err)
+				mapped, err := reg.Mapper().ToTuple(ctx, actualRTs...)
+				require.NoError(t, err)
+				return mapped
+			}
+
+			resp := transfer(t, "mallory")
+			assert.Equal(t, http.StatusConflict, resp.StatusCode)
+			assert.Equal(t, []*ketoapi.RelationTuple{owner("alice")}, owners(t))
+
+			resp = transfer(t, "alice")
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+			assert.Equal(t, []*ketoapi.RelationTuple{owner("bob")}, owners(t))
+
+			t.Run("case=invalid precondition", func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodPatch, ts.URL+relationtuple.WriteRouteBase, bytes.NewBufferString(`{"deltas": [], "preconditions": [{}]}`))
+				require.NoError(t, err)
+				resp, err := ts.Client().Do(req)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			})
+		})
+
 		t.Run("case=unknown action", func(t *testing.T) {
 			rawJSON := `
 [
@@ -414,3 +472,196 @@ func TestWriteHandlers(t *testing.T) {
 		})
 	})
 }
+
+func TestTransactPreconditions(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "groups"}}))
+
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.UnaryInterceptor(herodot.UnaryErrorUnwrapInterceptor))
+	relationtuple.NewHandler(reg).RegisterWriteGRPC(s)
+	go func() {
+		if err := s.Serve(l); err != nil {
+			t.Logf("Server exited with error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := rts.NewWriteServiceClient(conn)
+
+	invite := &ketoapi.RelationTuple{Namespace: "groups", Object: "dev", Relation: "invited", SubjectID: pointerx.Ptr("alice")}
+	inviteIfAbsent := func() error {
+		_, err := client.TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+			RelationTupleDeltas: []*rts.RelationTupleDelta{{
+				Action:        rts.RelationTupleDelta_ACTION_INSERT,
+				RelationTuple: invite.ToProto(),
+			}},
+			Preconditions: []*rts.Precondition{
+				(&ketoapi.Precondition{TupleNotExists: invite}).ToProto(),
+			},
+		})
+		return err
+	}
+
+	require.NoError(t, inviteIfAbsent())
+	err = inviteIfAbsent()
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), relationtuple.ErrPreconditionFailed.ErrorField)
+
+	t.Run("case=invalid precondition", func(t *testing.T) {
+		_, err := client.TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+			Preconditions: []*rts.Precondition{{}},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), ketoapi.ErrInvalidPrecondition.ErrorField)
+	})
+}
+
+func TestDeprecatedRelationWrites(t *testing.T) {
+	ctx := context.Background()
+	nn, errs := schema.Parse(`
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    editors: User[]
+    @deprecated("use editors")
+    owners: User[]
+  }
+}`)
+	require.Len(t, errs, 0)
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{&nn[0], &nn[1]}))
+
+	r := httprouter.New()
+	h := relationtuple.NewHandler(reg)
+	h.RegisterWriteRoutes(&x.WriteRouter{Router: r})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	tuple := func(relation, subject string) *ketoapi.RelationTuple {
+		return &ketoapi.RelationTuple{Namespace: "Document", Object: "doc", Relation: relation, SubjectID: pointerx.Ptr(subject)}
+	}
+	const warning = `299 - "Document#owners is deprecated: use editors"`
+
+	t.Run("method=create", func(t *testing.T) {
+		for _, tc := range []struct {
+			relation string
+			warnings []string
+		}{
+			{relation: "owners", warnings: []string{warning}},
+			{relation: "editors"},
+		} {
+			t.Run("relation="+tc.relation, func(t *testing.T) {
+				before := deprecatedWrites(t)
+				payload, err := json.Marshal(tuple(tc.relation, "create"))
+				require.NoError(t, err)
+				req, err := http.NewRequest(http.MethodPut, ts.URL+relationtuple.WriteRouteBase, bytes.NewBuffer(payload))
+				require.NoError(t, err)
+				resp, err := ts.Client().Do(req)
+				require.NoError(t, err)
+
+				assert.Equal(t, http.StatusCreated, resp.StatusCode)
+				assert.Equal(t, tc.warnings, resp.Header.Values("Warning"))
+				assert.Equal(t, before+float64(len(tc.warnings)), deprecatedWrites(t))
+			})
+		}
+	})
+
+	t.Run("method=patch", func(t *testing.T) {
+		before := deprecatedWrites(t)
+		payload, err := json.Marshal([]*ketoapi.PatchDelta{
+			{Action: ketoapi.ActionInsert, RelationTuple: tuple("owners", "patch1")},
+			{Action: ketoapi.ActionInsert, RelationTuple: tuple("owners", "patch2")},
+			{Action: ketoapi.ActionInsert, RelationTuple: tuple("editors", "patch1")},
+			{Action: ketoapi.ActionDelete, RelationTuple: tuple("owners", "create")},
+		})
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPatch, ts.URL+relationtuple.WriteRouteBase, bytes.NewBuffer(payload))
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		// one warning per relation, but every inserted relationship is counted
+		assert.Equal(t, []string{warning}, resp.Header.Values("Warning"))
+		assert.Equal(t, before+2, deprecatedWrites(t))
+	})
+
+	t.Run("method=gRPC", func(t *testing.T) {
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer()
+		h.RegisterWriteGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+		client := rts.NewWriteServiceClient(conn)
+
+		for _, tc := range []struct {
+			relation string
+			warnings []string
+		}{
+			{relation: "owners", warnings: []string{"Document#owners is deprecated: use editors"}},
+			{relation: "editors"},
+		} {
+			t.Run("rpc=transact/relation="+tc.relation, func(t *testing.T) {
+				before := deprecatedWrites(t)
+				var trailer metadata.MD
+				_, err := client.TransactRelationTuples(ctx, &rts.TransactRelationTuplesRequest{
+					RelationTupleDeltas: []*rts.RelationTupleDelta{{
+						Action:        rts.RelationTupleDelta_ACTION_INSERT,
+						RelationTuple: tuple(tc.relation, "grpc").ToProto(),
+					}},
+				}, grpc.Trailer(&trailer))
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.warnings, trailer.Get(relationtuple.DeprecationTrailer))
+				assert.Equal(t, before+float64(len(tc.warnings)), deprecatedWrites(t))
+			})
+		}
+
+		t.Run("rpc=import", func(t *testing.T) {
+			before := deprecatedWrites(t)
+			stream, err := rts.NewBulkServiceClient(conn).ImportRelationTuples(ctx)
+			require.NoError(t, err)
+			require.NoError(t, stream.Send(&rts.ImportRelationTuplesRequest{RelationTuples: []*rts.RelationTuple{
+				tuple("owners", "import1").ToProto(),
+				tuple("owners", "import2").ToProto(),
+				tuple("editors", "import1").ToProto(),
+			}}))
+			_, err = stream.CloseAndRecv()
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"Document#owners is deprecated: use editors"}, stream.Trailer().Get(relationtuple.DeprecationTrailer))
+			assert.Equal(t, before+2, deprecatedWrites(t))
+		})
+	})
+}
+
+// deprecatedWrites returns the sum of the deprecated writes metric over all
+// relations.
+func deprecatedWrites(t *testing.T) (sum float64) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "keto_deprecated_relation_writes_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			sum += m.GetCounter().GetValue()
+		}
+	}
+	return sum
+}
@@ -328,6 +328,21 @@ func (m *Mapper) FromSubjectSet(ctx context.Context, set *ketoapi.SubjectSet) (_
 	}, nil
 }
 
+// FromObjects validates the namespace and maps the objects of it to UUIDs.
+func (m *Mapper) FromObjects(ctx context.Context, namespace string, objects ...string) (_ []uuid.UUID, err error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("keto/internal/relationtuple").Start(ctx, "Mapper.FromObjects")
+	defer otelx.End(span, &err)
+
+	nm, err := m.D.Config(ctx).NamespaceManager()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := nm.GetNamespaceByName(ctx, namespace); err != nil {
+		return nil, err
+	}
+	return m.D.MappingManager().MapStringsToUUIDs(ctx, objects...)
+}
+
 func (m *Mapper) ToTree(ctx context.Context, tree *Tree) (res *ketoapi.Tree[*ketoapi.RelationTuple], err error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("keto/internal/relationtuple").Start(ctx, "Mapper.ToTree")
 	defer otelx.End(span, &err)
@@ -382,6 +397,38 @@ func (m *Mapper) ToTree(ctx context.Context, tree *Tree) (res *ketoapi.Tree[*ket
 	return res, nil
 }
 
+// ToCheckTree maps the tree explaining a check result to its API
+// representation. Nodes without a tuple, such as intersections, are kept
+// without a tuple.
+func (m *Mapper) ToCheckTree(ctx context.Context, tree *ketoapi.Tree[*RelationTuple]) (res *ketoapi.Tree[*ketoapi.RelationTuple], err error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("keto/internal/relationtuple").Start(ctx, "Mapper.ToCheckTree")
+	defer otelx.End(span, &err)
+
+	if tree == nil {
+		return nil, nil
+	}
+
+	res = &ketoapi.Tree[*ketoapi.RelationTuple]{
+		Type: tree.Type,
+	}
+	if tree.Tuple != nil {
+		mt, err := m.ToTuple(ctx, tree.Tuple)
+		if err != nil {
+			return nil, err
+		}
+		res.Tuple = mt[0]
+	}
+	for _, c := range tree.Children {
+		mc, err := m.ToCheckTree(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		res.Children = append(res.Children, mc)
+	}
+
+	return res, nil
+}
+
 func MappingManagerTest(t *testing.T, m MappingManager) {
 	ctx := context.Background()
 
@@ -0,0 +1,279 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/graceful"
+	"github.com/ory/herodot"
+	"github.com/pkg/errors"
+
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	WatchRouteBase = ReadRouteBase + "/watch"
+
+	// watchBatchSize is about the number of changes that are read from the
+	// changelog at once.
+	watchBatchSize = 500
+
+	defaultWatchDuration = 5 * time.Second
+)
+
+// REST watch requests end before the write timeout of the server. Server sent
+// event clients reconnect right away and resume from the last event.
+var maxWatchDuration = graceful.DefaultWriteTimeout - time.Second
+
+var _ rts.WatchServiceServer = (*handler)(nil)
+
+// nextChanges reads the changes after the cursor from the changelog. The
+// returned cursor points after the read changes, it also advances over
+// changes that are filtered out.
+func (h *handler) nextChanges(ctx context.Context, cursor, namespace string) ([]*ketoapi.RelationTupleChanges, string, error) {
+	entries, err := h.d.RelationTupleChangelog().GetRelationTupleChanges(ctx, cursor, watchBatchSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := make([]*ketoapi.RelationTupleChanges, 0, len(entries))
+	for _, e := range entries {
+		cursor = e.Cursor
+
+		var (
+			actions []ketoapi.PatchAction
+			tuples  []*RelationTuple
+		)
+		for _, c := range e.Changes {
+			if namespace != "" && c.RelationTuple.Namespace != namespace {
+				continue
+			}
+			actions = append(actions, c.Action)
+			tuples = append(tuples, c.RelationTuple)
+		}
+		if len(tuples) == 0 {
+			continue
+		}
+
+		mapped, err := h.d.Mapper().ToTuple(ctx, tuples...)
+		if err != nil {
+			return nil, "", err
+		}
+		changes := &ketoapi.RelationTupleChanges{
+			Deltas:     make([]*ketoapi.PatchDelta, len(mapped)),
+			Cursor:     e.Cursor,
+			CommitTime: e.CommitTime,
+		}
+		for i, t := range mapped {
+			changes.Deltas[i] = &ketoapi.PatchDelta{Action: actions[i], RelationTuple: t}
+		}
+		res = append(res, changes)
+	}
+	return res, cursor, nil
+}
+
+// waitForChanges polls the changelog until there are changes after the
+// cursor, or the context is done. An empty cursor starts at the latest change.
+func (h *handler) waitForChanges(ctx context.Context, cursor, namespace string) ([]*ketoapi.RelationTupleChanges, string, error) {
+	if cursor == "" {
+		var err error
+		if cursor, err = h.d.RelationTupleChangelog().LatestChangelogCursor(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for {
+		changes, next, err := h.nextChanges(ctx, cursor, namespace)
+		if ctx.Err() != nil {
+			return nil, cursor, nil
+		} else if err != nil {
+			return nil, "", err
+		}
+		if len(changes) > 0 {
+			return changes, next, nil
+		}
+		if next != cursor {
+			// all read changes were filtered out, but there might be more
+			cursor = next
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, cursor, nil
+		case <-time.After(h.d.Config(ctx).WatchPollInterval()):
+		}
+	}
+}
+
+func (h *handler) Watch(req *rts.WatchRequest, stream rts.WatchService_WatchServer) error {
+	ctx := stream.Context()
+	cursor := req.Cursor
+	for {
+		changes, next, err := h.waitForChanges(ctx, cursor, req.Namespace)
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		for _, c := range changes {
+			if err := stream.Send(c.ToProto()); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		cursor = next
+	}
+}
+
+// Watch Relationships Request Parameters
+//
+// swagger:parameters watchRelationships
+// nolint:deadcode,unused
+type watchRelationships struct {
+	// The cursor of the last received changes, to continue after them. If
+	// unset, only changes that are committed after the request are returned.
+	// Fails if the changes after the cursor were deleted from the changelog.
+	//
+	// in: query
+	Cursor string `json:"cursor"`
+
+	// Only return the changes of relationships in this namespace.
+	//
+	// in: query
+	Namespace string `json:"namespace"`
+
+	// How long to wait for changes, at most 9s. Defaults to 5s.
+	//
+	// in: query
+	Timeout string `json:"timeout"`
+}
+
+// swagger:route GET /relation-tuples/watch relationship watchRelationships
+//
+// # Watch relationship changes
+//
+// Returns the inserts and deletes of relationships that were committed after
+// the cursor, in commit order. The request waits until there are changes or
+// the timeout is reached (long polling).
+//
+// With the `Accept: text/event-stream` header, the changes are streamed as
+// server sent events instead. Every event is one transaction and has the
+// cursor as its ID, so that clients can resume with the `Last-Event-ID`
+// header.
+//
+//	Consumes:
+//	-  application/x-www-form-urlencoded
+//
+//	Produces:
+//	- application/json
+//	- text/event-stream
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: watchRelationshipsResponse
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *handler) watchRelations(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	q := r.URL.Query()
+	cursor, namespace := q.Get("cursor"), q.Get("namespace")
+
+	timeout := defaultWatchDuration
+	if t := q.Get("timeout"); t != "" {
+		var err error
+		if timeout, err = time.ParseDuration(t); err != nil || timeout <= 0 {
+			h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Invalid timeout %q.", t)))
+			return
+		}
+	}
+	if timeout > maxWatchDuration {
+		timeout = maxWatchDuration
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		if id := r.Header.Get("Last-Event-ID"); id != "" {
+			cursor = id
+		}
+		h.streamChanges(w, r, cursor, namespace)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	changes, next, err := h.waitForChanges(ctx, cursor, namespace)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+	if changes == nil {
+		changes = []*ketoapi.RelationTupleChanges{}
+	}
+	h.d.Writer().Write(w, r, &ketoapi.WatchResponse{Changes: changes, Cursor: next})
+}
+
+// streamChanges writes the changes as server sent events.
+func (h *handler) streamChanges(w http.ResponseWriter, r *http.Request, cursor, namespace string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrInternalServerError.WithReason("Streaming is not supported.")))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), maxWatchDuration)
+	defer cancel()
+
+	// The first read validates the cursor, so that errors are returned
+	// before the stream starts.
+	if cursor == "" {
+		var err error
+		if cursor, err = h.d.RelationTupleChangelog().LatestChangelogCursor(ctx); err != nil {
+			h.d.Writer().WriteError(w, r, err)
+			return
+		}
+	}
+	changes, cursor, err := h.nextChanges(ctx, cursor, namespace)
+	if err != nil {
+		h.d.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	// reconnect right away when the stream ends
+	_, _ = fmt.Fprint(w, "retry: 100\n\n")
+	flusher.Flush()
+
+	for {
+		for _, c := range changes {
+			data, err := json.Marshal(c)
+			if err != nil {
+				h.d.Logger().WithError(err).Error("could not encode the relationship changes")
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: changes\ndata: %s\n\n", c.Cursor, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		if ctx.Err() != nil {
+			return
+		}
+		if changes, cursor, err = h.waitForChanges(ctx, cursor, namespace); err != nil {
+			h.d.Logger().WithError(err).Warn("could not watch the relationship changes")
+			return
+		}
+	}
+}
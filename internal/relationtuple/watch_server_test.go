@@ -0,0 +1,243 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package relationtuple_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/ory/herodot"
+	"github.com/ory/x/pointerx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ory/keto/internal/driver"
+	"github.com/ory/keto/internal/driver/config"
+	"github.com/ory/keto/internal/namespace"
+	"github.com/ory/keto/internal/relationtuple"
+	"github.com/ory/keto/internal/x"
+	"github.com/ory/keto/ketoapi"
+	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+	reg := driver.NewSqliteTestRegistry(t, false, driver.WithNamespaces([]*namespace.Namespace{{Name: "a"}, {Name: "b"}}))
+	require.NoError(t, reg.Config(ctx).Set(config.KeyWatchPollInterval, "10ms"))
+
+	h := relationtuple.NewHandler(reg)
+	r := httprouter.New()
+	h.RegisterReadRoutes(&x.ReadRouter{Router: r})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	tuple := func(namespace, object string) *ketoapi.RelationTuple {
+		return &ketoapi.RelationTuple{
+			Namespace: namespace,
+			Object:    object,
+			Relation:  "r",
+			SubjectID: pointerx.Ptr("s"),
+		}
+	}
+	write := func(t *testing.T, insert, del []*ketoapi.RelationTuple) {
+		its, err := reg.Mapper().FromTuple(ctx, insert...)
+		require.NoError(t, err)
+		dts, err := reg.Mapper().FromTuple(ctx, del...)
+		require.NoError(t, err)
+		require.NoError(t, reg.RelationTupleManager().TransactRelationTuples(ctx, its, dts))
+	}
+	latest := func(t *testing.T) string {
+		c, err := reg.RelationTupleChangelog().LatestChangelogCursor(ctx)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("method=gRPC", func(t *testing.T) {
+		l := bufconn.Listen(1024 * 1024)
+		s := grpc.NewServer(grpc.StreamInterceptor(herodot.StreamErrorUnwrapInterceptor))
+		h.RegisterReadGRPC(s)
+		go func() {
+			if err := s.Serve(l); err != nil {
+				t.Logf("Server exited with error: %v", err)
+			}
+		}()
+		t.Cleanup(s.Stop)
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		client := rts.NewWatchServiceClient(conn)
+
+		t.Run("case=streams the changes in commit order", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := client.Watch(ctx, &rts.WatchRequest{Cursor: latest(t)})
+			require.NoError(t, err)
+
+			write(t, []*ketoapi.RelationTuple{tuple("a", "grpc-1"), tuple("b", "grpc-1")}, nil)
+			write(t, nil, []*ketoapi.RelationTuple{tuple("a", "grpc-1")})
+
+			first, err := stream.Recv()
+			require.NoError(t, err)
+			require.Len(t, first.RelationTupleDeltas, 2)
+			assert.Equal(t, rts.RelationTupleDelta_ACTION_INSERT, first.RelationTupleDeltas[0].Action)
+			assert.Equal(t, "grpc-1", first.RelationTupleDeltas[0].RelationTuple.Object)
+			assert.NotNil(t, first.CommitTime)
+
+			second, err := stream.Recv()
+			require.NoError(t, err)
+			require.Len(t, second.RelationTupleDeltas, 1)
+			assert.Equal(t, rts.RelationTupleDelta_ACTION_DELETE, second.RelationTupleDeltas[0].Action)
+			assert.Equal(t, latest(t), second.Cursor)
+		})
+
+		t.Run("case=filters by namespace", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := client.Watch(ctx, &rts.WatchRequest{Cursor: latest(t), Namespace: "b"})
+			require.NoError(t, err)
+
+			write(t, []*ketoapi.RelationTuple{tuple("a", "grpc-2")}, nil)
+			write(t, []*ketoapi.RelationTuple{tuple("a", "grpc-3"), tuple("b", "grpc-3")}, nil)
+
+			res, err := stream.Recv()
+			require.NoError(t, err)
+			require.Len(t, res.RelationTupleDeltas, 1)
+			assert.Equal(t, "b", res.RelationTupleDeltas[0].RelationTuple.Namespace)
+			assert.Equal(t, latest(t), res.Cursor)
+		})
+
+		t.Run("case=malformed cursor", func(t *testing.T) {
+			stream, err := client.Watch(ctx, &rts.WatchRequest{Cursor: "nope"})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err), "%+v", err)
+		})
+	})
+
+	t.Run("method=long poll", func(t *testing.T) {
+		poll := func(t *testing.T, query url.Values) (*http.Response, *ketoapi.WatchResponse) {
+			resp, err := ts.Client().Get(ts.URL + relationtuple.WatchRouteBase + "?" + query.Encode())
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			var res ketoapi.WatchResponse
+			if resp.StatusCode == http.StatusOK {
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+			}
+			return resp, &res
+		}
+
+		t.Run("case=returns the changes after the cursor", func(t *testing.T) {
+			cursor := latest(t)
+			write(t, []*ketoapi.RelationTuple{tuple("a", "poll-1")}, nil)
+			write(t, []*ketoapi.RelationTuple{tuple("b", "poll-1")}, nil)
+
+			resp, res := poll(t, url.Values{"cursor": {cursor}})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Len(t, res.Changes, 2)
+			assert.Equal(t, ketoapi.ActionInsert, res.Changes[0].Deltas[0].Action)
+			assert.Equal(t, "poll-1", res.Changes[0].Deltas[0].RelationTuple.Object)
+			assert.Equal(t, "b", res.Changes[1].Deltas[0].RelationTuple.Namespace)
+			assert.Equal(t, latest(t), res.Cursor)
+
+			resp, res = poll(t, url.Values{"cursor": {cursor}, "namespace": {"b"}})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Len(t, res.Changes, 1)
+			assert.Equal(t, "b", res.Changes[0].Deltas[0].RelationTuple.Namespace)
+		})
+
+		t.Run("case=times out without changes", func(t *testing.T) {
+			cursor := latest(t)
+			resp, res := poll(t, url.Values{"cursor": {cursor}, "timeout": {"50ms"}})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Empty(t, res.Changes)
+			assert.Equal(t, cursor, res.Cursor)
+		})
+
+		t.Run("case=invalid parameters", func(t *testing.T) {
+			resp, _ := poll(t, url.Values{"cursor": {"nope"}})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			resp, _ = poll(t, url.Values{"timeout": {"soon"}})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	})
+
+	t.Run("method=server sent events", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		cursor := latest(t)
+		write(t, []*ketoapi.RelationTuple{tuple("a", "sse-1")}, nil)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+relationtuple.WatchRouteBase, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "text/event-stream")
+		req.Header.Set("Last-Event-ID", cursor)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		events := make(chan [2]string)
+		go func() {
+			defer close(events)
+			var id string
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				line := scanner.Text()
+				if v, ok := strings.CutPrefix(line, "id: "); ok {
+					id = v
+				} else if v, ok := strings.CutPrefix(line, "data: "); ok {
+					select {
+					case events <- [2]string{id, v}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+
+		next := func(t *testing.T) (string, *ketoapi.RelationTupleChanges) {
+			select {
+			case e := <-events:
+				var c ketoapi.RelationTupleChanges
+				require.NoError(t, json.Unmarshal([]byte(e[1]), &c))
+				return e[0], &c
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "no event received")
+				return "", nil
+			}
+		}
+
+		id, c := next(t)
+		assert.Equal(t, "sse-1", c.Deltas[0].RelationTuple.Object)
+		assert.Equal(t, c.Cursor, id)
+
+		write(t, []*ketoapi.RelationTuple{tuple("b", "sse-2")}, nil)
+		id, c = next(t)
+		assert.Equal(t, "sse-2", c.Deltas[0].RelationTuple.Object)
+		assert.Equal(t, latest(t), id)
+	})
+}
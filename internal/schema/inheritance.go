@@ -0,0 +1,123 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import "github.com/ory/keto/internal/namespace/ast"
+
+type resolveState int
+
+const (
+	unresolved resolveState = iota
+	resolving
+	resolved
+	failed
+)
+
+// resolveInheritance flattens all classes that extend another class. A class
+// inherits all relations and permits of its parent, and can override them. The
+// inherited relations come first, in the order of the parent class.
+func (p *parser) resolveInheritance() {
+	if len(p.parents) == 0 {
+		return
+	}
+
+	states := make(map[string]resolveState, len(p.namespaces))
+	var resolve func(i int) bool
+	resolve = func(i int) bool {
+		child := &p.namespaces[i]
+		switch states[child.Name] {
+		case resolved:
+			return true
+		case failed:
+			return false
+		case resolving:
+			p.addErr(p.parents[child.Name], "class %q extends itself", child.Name)
+			states[child.Name] = failed
+			return false
+		}
+
+		extends, ok := p.parents[child.Name]
+		if !ok {
+			states[child.Name] = resolved
+			return true
+		}
+
+		states[child.Name] = resolving
+		j := p.namespaceIndex(extends.Val)
+		if j < 0 {
+			p.addErr(extends, "class %q was not declared", extends.Val)
+			states[child.Name] = failed
+			return false
+		}
+		if !resolve(j) {
+			states[child.Name] = failed
+			return false
+		}
+
+		child.Relations = p.inherit(child, &p.namespaces[j], extends)
+		states[child.Name] = resolved
+		return true
+	}
+
+	for i := range p.namespaces {
+		resolve(i)
+	}
+}
+
+func (p *parser) namespaceIndex(name string) int {
+	for i := range p.namespaces {
+		if p.namespaces[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// inherit returns the relations of the parent, overridden by the ones of the
+// child, followed by the relations that only the child declares. Relations can
+// only be overridden by relations, and permits only by permits.
+func (p *parser) inherit(child, parent *namespace, extends item) []ast.Relation {
+	relations := make([]ast.Relation, 0, len(parent.Relations)+len(child.Relations))
+	for _, r := range parent.Relations {
+		override, ok := relationQuery(child.Relations).find(r.Name)
+		if !ok {
+			relations = append(relations, r)
+			if r.SubjectSetRewrite != nil {
+				p.addCheck(checkInheritedRewrite(child.Name, extends, r.SubjectSetRewrite))
+			}
+			continue
+		}
+		if isPermit(*override) != isPermit(r) {
+			p.addErr(extends, "%s %q of class %q can't be overridden by a %s in class %q",
+				relationKind(r), r.Name, parent.Name, relationKind(*override), child.Name)
+		}
+		relations = append(relations, *override)
+	}
+	for _, r := range child.Relations {
+		if _, ok := relationQuery(parent.Relations).find(r.Name); !ok {
+			relations = append(relations, r)
+		}
+	}
+	return relations
+}
+
+// checkInheritedRewrite checks that all relations of an inherited permit are
+// still valid with the relation types of the class that inherits it, as the
+// class might have overridden them.
+func checkInheritedRewrite(namespace string, extends item, rewrite *ast.SubjectSetRewrite) typeCheck {
+	return func(p *parser) {
+		p.checkRewriteRelations(extends, []string{namespace}, rewrite)
+	}
+}
+
+func isPermit(r ast.Relation) bool {
+	return r.SubjectSetRewrite != nil
+}
+
+func relationKind(r ast.Relation) string {
+	if isPermit(r) {
+		return "permit"
+	}
+	return "relation"
+}
@@ -30,19 +30,20 @@ func _() {
 	_ = x[itemOperatorComma-16]
 	_ = x[itemSemicolon-17]
 	_ = x[itemTypeUnion-18]
-	_ = x[itemParenLeft-19]
-	_ = x[itemParenRight-20]
-	_ = x[itemBraceLeft-21]
-	_ = x[itemBraceRight-22]
-	_ = x[itemBracketLeft-23]
-	_ = x[itemBracketRight-24]
-	_ = x[itemAngledLeft-25]
-	_ = x[itemAngledRight-26]
+	_ = x[itemDecorator-19]
+	_ = x[itemParenLeft-20]
+	_ = x[itemParenRight-21]
+	_ = x[itemBraceLeft-22]
+	_ = x[itemBraceRight-23]
+	_ = x[itemBracketLeft-24]
+	_ = x[itemBracketRight-25]
+	_ = x[itemAngledLeft-26]
+	_ = x[itemAngledRight-27]
 }
 
-const _itemType_name = "ErrorEOFIdentifierCommentStringLiteralKeywordClassKeywordImplementsKeywordThisKeywordCtx\"&&\"\"||\"\"!\"\"=\"\"=>\"\".\"\":\"\",\"\";\"\"|\"\"(\"\")\"\"{\"\"}\"\"[\"\"]\"\"<\"\">\""
+const _itemType_name = "ErrorEOFIdentifierCommentStringLiteralKeywordClassKeywordImplementsKeywordThisKeywordCtx\"&&\"\"||\"\"!\"\"=\"\"=>\"\".\"\":\"\",\"\";\"\"|\"\"@\"\"(\"\")\"\"{\"\"}\"\"[\"\"]\"\"<\"\">\""
 
-var _itemType_index = [...]uint8{0, 5, 8, 18, 25, 38, 50, 67, 78, 88, 92, 96, 99, 102, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139, 142, 145}
+var _itemType_index = [...]uint8{0, 5, 8, 18, 25, 38, 50, 67, 78, 88, 92, 96, 99, 102, 106, 109, 112, 115, 118, 121, 124, 127, 130, 133, 136, 139, 142, 145, 148}
 
 func (i itemType) String() string {
 	if i < 0 || i >= itemType(len(_itemType_index)-1) {
@@ -69,6 +69,7 @@ const (
 	// misc characters
 	itemSemicolon // ";"
 	itemTypeUnion // "|"
+	itemDecorator // "@"
 
 	// brackets
 	itemParenLeft    // "("
@@ -189,13 +190,32 @@ func (l *lexer) nextItem() item {
 	}
 }
 
-// nextNonCommentItem returns the next item from the input that is not a comment.
-func (l *lexer) nextNonCommentItem() (item item) {
+// nextNonCommentItem returns the next item from the input that is not a
+// comment, and the last doc comment ("/** ... */") before it.
+func (l *lexer) nextNonCommentItem() (item item, doc string) {
 	for item = l.nextItem(); item.Typ == itemComment; item = l.nextItem() {
+		if isDocComment(item.Val) {
+			doc = item.Val
+		}
 	}
 	return
 }
 
+func isDocComment(comment string) bool {
+	return strings.HasPrefix(comment, "/**") && comment != "/**/"
+}
+
+// docCommentText returns the text of a doc comment, without the delimiters and
+// the leading "*" of every line.
+func docCommentText(comment string) string {
+	comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/**"), "*/")
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
 // scanIdentifier scans an identifier.
 func (l *lexer) scanIdentifier() bool {
 	if !l.accept(letters) {
@@ -233,6 +253,7 @@ var oneRuneTokens = map[rune]itemType{
 	';': itemSemicolon,
 	'|': itemTypeUnion,
 	'!': itemOperatorNot,
+	'@': itemDecorator,
 }
 
 var multiRuneTokens = map[string]itemType{
@@ -8,7 +8,7 @@ const (
 	// for looking up the types of SubjectSet<Namespace, "relation">.
 	tupleToSubjectSetTypeCheckMaxDepth = 10
 
-	// expressionNestingMaxDepth is the maximum number of nested '(' and '!' in
-	// a single 'permits'.
+	// expressionNestingMaxDepth is the maximum number of nested '(', '!', and
+	// traversals in a single 'permits'.
 	expressionNestingMaxDepth = 10
 )
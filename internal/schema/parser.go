@@ -14,13 +14,16 @@ type (
 	namespace = internalNamespace.Namespace
 
 	parser struct {
-		lexer      *lexer        // lexer to get tokens from
-		namespaces []namespace   // list of parsed namespaces
-		namespace  namespace     // current namespace
-		errors     []*ParseError // errors encountered during parsing
-		fatal      bool          // parser encountered a fatal error
-		lookahead  *item         // lookahead token
-		checks     []typeCheck   // checks to perform on the namespace
+		lexer      *lexer          // lexer to get tokens from
+		namespaces []namespace     // list of parsed namespaces
+		namespace  namespace       // current namespace
+		errors     []*ParseError   // errors encountered during parsing
+		fatal      bool            // parser encountered a fatal error
+		lookahead  *item           // lookahead token
+		doc        string          // doc comment before the last token
+		docAhead   string          // doc comment before the lookahead token
+		checks     []typeCheck     // checks to perform on the namespace
+		parents    map[string]item // parent class of every class that extends one
 	}
 )
 
@@ -33,18 +36,18 @@ func Parse(input string) ([]namespace, []*ParseError) {
 
 func (p *parser) next() (item item) {
 	if p.lookahead != nil {
-		item = *p.lookahead
+		item, p.doc = *p.lookahead, p.docAhead
 		p.lookahead = nil
 	} else {
-		return p.lexer.nextNonCommentItem()
+		item, p.doc = p.lexer.nextNonCommentItem()
 	}
 	return
 }
 
 func (p *parser) peek() item {
 	if p.lookahead == nil {
-		i := p.lexer.nextNonCommentItem()
-		p.lookahead = &i
+		i, doc := p.lexer.nextNonCommentItem()
+		p.lookahead, p.docAhead = &i, doc
 		return i
 	}
 	return *p.lookahead
@@ -63,6 +66,9 @@ loop:
 		}
 	}
 
+	if len(p.errors) == 0 {
+		p.resolveInheritance()
+	}
 	if len(p.errors) == 0 {
 		p.typeCheck()
 	}
@@ -168,9 +174,24 @@ func (p *parser) matchIf(predicate itemPredicate, tokens ...interface{}) (matche
 // parseClass parses a class. The "class" token was already consumed.
 func (p *parser) parseClass() {
 	var name string
-	p.match(&name, "implements", "Namespace", "{")
+	p.match(&name)
 	p.namespace = namespace{Name: name}
 
+	if p.peek().Val == "extends" {
+		var parent item
+		p.match("extends", &parent, optional("implements", "Namespace"), "{")
+		if parent.Typ != itemIdentifier {
+			p.addFatal(parent, "expected identifier, got %s", parent.Typ)
+			return
+		}
+		if p.parents == nil {
+			p.parents = make(map[string]item)
+		}
+		p.parents[name] = parent
+	} else {
+		p.match("implements", "Namespace", "{")
+	}
+
 	for !p.fatal {
 		switch item := p.next(); {
 		case item.Typ == itemBraceRight:
@@ -200,8 +221,12 @@ func (p *parser) parseRelated() {
 		case itemBraceRight:
 			return
 
-		case itemIdentifier, itemStringLiteral:
-			relation := item.Val
+		case itemDecorator, itemIdentifier, itemStringLiteral:
+			name, annotations := p.parseAnnotations(item)
+			if p.fatal {
+				return
+			}
+			relation := name.Val
 			var types []ast.RelationType
 			p.match(":")
 
@@ -221,10 +246,10 @@ func (p *parser) parseRelated() {
 				p.match("[", "]", optional(","))
 			}
 
-			p.namespace.Relations = append(p.namespace.Relations, ast.Relation{
+			p.namespace.Relations = append(p.namespace.Relations, annotations.apply(ast.Relation{
 				Name:  relation,
 				Types: types,
-			})
+			}))
 
 		default:
 			p.addFatal(item, "expected identifier or '}', got %s %q", item.Typ.String(), item.Val)
@@ -233,6 +258,59 @@ func (p *parser) parseRelated() {
 	}
 }
 
+// annotations are the doc comment and the decorators of a relation or permit.
+type annotations struct {
+	description       string
+	deprecated        bool
+	deprecationReason string
+}
+
+func (a annotations) apply(r ast.Relation) ast.Relation {
+	r.Description = a.description
+	r.Deprecated = a.deprecated
+	r.DeprecationReason = a.deprecationReason
+	return r
+}
+
+// parseAnnotations parses the decorators starting at the given item, and
+// returns the name of the relation or permit that follows them. The doc
+// comment is the last one before the decorators or the name.
+func (p *parser) parseAnnotations(first item) (name item, a annotations) {
+	for name = first; !p.fatal; name = p.next() {
+		if p.doc != "" {
+			a.description = docCommentText(p.doc)
+		}
+		if name.Typ != itemDecorator {
+			break
+		}
+
+		var decorator item
+		p.match(&decorator)
+		switch decorator.Val {
+		case "deprecated":
+			if a.deprecated {
+				p.addErr(decorator, "duplicate decorator @deprecated")
+			}
+			a.deprecated = true
+			if p.matchIf(is(itemParenLeft), "(") && !p.matchIf(is(itemParenRight), ")") {
+				var reason item
+				p.match(&reason, optional(","), ")")
+				if reason.Typ != itemStringLiteral {
+					p.addFatal(reason, "expected string literal, got %s %q", reason.Typ.String(), reason.Val)
+					return
+				}
+				a.deprecationReason = reason.Val
+			}
+		default:
+			p.addErr(decorator, "unknown decorator @%s, expected @deprecated", decorator.Val)
+		}
+	}
+	if !p.fatal && name.Typ != itemIdentifier && name.Typ != itemStringLiteral {
+		p.addFatal(name, "expected identifier, got %s %q", name.Typ.String(), name.Val)
+	}
+	return
+}
+
 func (p *parser) matchSubjectSet() ast.RelationType {
 	var namespace, relation item
 	p.match("<", &namespace, ",", &relation, ">")
@@ -261,6 +339,33 @@ func (p *parser) parseTypeUnion(endToken itemType) (types []ast.RelationType) {
 	return
 }
 
+// scope is the object that permission expressions refer to. It is either
+// "this", or the argument of a traversal, which is reached by traversing the
+// path of relations from the current namespace.
+type scope struct {
+	name string
+	path []item
+}
+
+var thisScope = scope{name: "this"}
+
+// hasRelation returns a check that all namespaces of the scope declare the
+// relation.
+func (s scope) hasRelation(p *parser, relation item) typeCheck {
+	if len(s.path) == 0 {
+		return checkCurrentNamespaceHasRelation(&p.namespace, relation)
+	}
+	return checkTraversedNamespacesHaveRelation(&p.namespace, s.path, relation)
+}
+
+// traverse returns the scope of a traversal of the relation with the given
+// lambda argument.
+func (s scope) traverse(arg, relation item) scope {
+	path := make([]item, len(s.path), len(s.path)+1)
+	copy(path, s.path)
+	return scope{name: arg.Val, path: append(path, relation)}
+}
+
 func (p *parser) parsePermits() {
 	p.match("=", "{")
 	for !p.fatal {
@@ -269,22 +374,30 @@ func (p *parser) parsePermits() {
 		case itemBraceRight:
 			return
 
-		case itemIdentifier, itemStringLiteral:
-			permission := item.Val
-			p.match(
-				":", "(", "ctx", optional(":", "Context"), ")",
-				optional(":", "boolean"), "=>",
-			)
+		case itemDecorator, itemIdentifier, itemStringLiteral:
+			name, annotations := p.parseAnnotations(item)
+			if p.fatal {
+				return
+			}
+			permission := name.Val
+			var subjectTypes []ast.RelationType
+			p.match(":", "(", "ctx")
+			if p.matchIf(is(itemOperatorColon), ":", "Context") && p.matchIf(is(itemAngledLeft), "<") {
+				subjectTypes = p.parseTypeUnion(itemAngledRight)
+				p.addCheck(checkPermitReachesSubjectTypes(&p.namespace, name, subjectTypes))
+			}
+			p.match(")", optional(":", "boolean"), "=>")
 
-			rewrite := simplifyExpression(p.parsePermissionExpressions(itemOperatorComma, expressionNestingMaxDepth))
+			rewrite := simplifyExpression(p.parsePermissionExpressions(itemOperatorComma, expressionNestingMaxDepth, thisScope))
 			if rewrite == nil {
 				return
 			}
 			p.namespace.Relations = append(p.namespace.Relations,
-				ast.Relation{
+				annotations.apply(ast.Relation{
 					Name:              permission,
 					SubjectSetRewrite: rewrite,
-				})
+					SubjectTypes:      subjectTypes,
+				}))
 
 		default:
 			p.addFatal(item, "expected identifier or '}', got %s %q", item.Typ.String(), item.Val)
@@ -293,7 +406,7 @@ func (p *parser) parsePermits() {
 	}
 }
 
-func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast.SubjectSetRewrite {
+func (p *parser) parsePermissionExpressions(finalToken itemType, depth int, s scope) *ast.SubjectSetRewrite {
 	if depth <= 0 {
 		p.addFatal(p.peek(),
 			"expression nested too deeply; maximal nesting depth is %d",
@@ -314,7 +427,7 @@ func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast
 		// A "(" starts a new expression group that is parsed recursively.
 		case item.Typ == itemParenLeft:
 			p.next() // consume paren
-			child := p.parsePermissionExpressions(itemParenRight, depth-1)
+			child := p.parsePermissionExpressions(itemParenRight, depth-1, s)
 			if child == nil {
 				return nil
 			}
@@ -325,6 +438,14 @@ func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast
 			p.next() // consume final token
 			return root
 
+		// The body of a traversal can have a trailing comma.
+		case item.Typ == itemOperatorComma && finalToken == itemParenRight:
+			p.next() // consume comma
+			if !p.match(")") {
+				return nil
+			}
+			return root
+
 		case item.Typ == itemBraceRight:
 			// We don't consume the '}' here, to allow `parsePermits` to consume
 			// it.
@@ -349,7 +470,7 @@ func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast
 		// single expression, or a list of expressions grouped by "()".
 		case item.Typ == itemOperatorNot:
 			p.next() // consume operator
-			child := p.parseNotExpression(depth - 1)
+			child := p.parseNotExpression(depth-1, s)
 			if child == nil {
 				return nil
 			}
@@ -363,7 +484,7 @@ func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast
 				p.addFatal(item, "did not expect another expression")
 				return nil
 			}
-			child := p.parsePermissionExpression()
+			child := p.parsePermissionExpression(depth, s)
 			if child == nil {
 				return nil
 			}
@@ -374,7 +495,7 @@ func (p *parser) parsePermissionExpressions(finalToken itemType, depth int) *ast
 	return nil
 }
 
-func (p *parser) parseNotExpression(depth int) ast.Child {
+func (p *parser) parseNotExpression(depth int, s scope) ast.Child {
 	if depth <= 0 {
 		p.addFatal(p.peek(),
 			"expression nested too deeply; maximal nesting depth is %d",
@@ -385,9 +506,9 @@ func (p *parser) parseNotExpression(depth int) ast.Child {
 	var child ast.Child
 	if item := p.peek(); item.Typ == itemParenLeft {
 		p.next() // consume paren
-		child = p.parsePermissionExpressions(itemParenRight, depth-1)
+		child = p.parsePermissionExpressions(itemParenRight, depth-1, s)
 	} else {
-		child = p.parsePermissionExpression()
+		child = p.parsePermissionExpression(depth, s)
 	}
 	if child == nil {
 		return nil
@@ -414,10 +535,10 @@ func setOperation(typ itemType) ast.Operator {
 	panic("not reached")
 }
 
-func (p *parser) parsePermissionExpression() (child ast.Child) {
+func (p *parser) parsePermissionExpression(depth int, s scope) (child ast.Child) {
 	var name, verb item
 
-	if !p.match("this", ".", &verb, ".", &name) {
+	if !p.match(s.name, ".", &verb, ".", &name) {
 		return
 	}
 
@@ -428,9 +549,9 @@ func (p *parser) parsePermissionExpression() (child ast.Child) {
 		}
 		switch item := p.next(); item.Val {
 		case "traverse":
-			child = p.parseTupleToSubjectSet(name)
+			child = p.parseTraversal(name, depth, s)
 		case "includes":
-			child = p.parseComputedSubjectSet(name)
+			child = p.parseComputedSubjectSet(name, s)
 		default:
 			p.addFatal(item, "expected 'traverse' or 'includes', got %q", item.Val)
 		}
@@ -439,7 +560,7 @@ func (p *parser) parsePermissionExpression() (child ast.Child) {
 		if !p.match("(", "ctx", ")") {
 			return
 		}
-		p.addCheck(checkCurrentNamespaceHasRelation(&p.namespace, name))
+		p.addCheck(s.hasRelation(p, name))
 		return &ast.ComputedSubjectSet{Relation: name.Val}
 
 	default:
@@ -450,11 +571,17 @@ func (p *parser) parsePermissionExpression() (child ast.Child) {
 	return
 }
 
-func (p *parser) parseTupleToSubjectSet(relation item) (rewrite ast.Child) {
-	var (
-		subjectSetRel string
-		arg, verb     item
-	)
+// parseTraversal parses the lambda of a traversal. The body of the lambda is
+// a permission expression on the lambda argument, which can traverse further.
+func (p *parser) parseTraversal(relation item, depth int, s scope) (rewrite ast.Child) {
+	if depth <= 0 {
+		p.addFatal(relation,
+			"expression nested too deeply; maximal nesting depth is %d",
+			expressionNestingMaxDepth)
+		return nil
+	}
+
+	var arg item
 	if !p.match("(") {
 		return nil
 	}
@@ -465,38 +592,37 @@ func (p *parser) parseTupleToSubjectSet(relation item) (rewrite ast.Child) {
 	default:
 		return nil
 	}
-	p.match("=>", arg.Val, ".", &verb)
+	if !p.match("=>") {
+		return nil
+	}
+	p.addCheck(s.hasRelation(p, relation))
 
-	switch verb.Val {
-	case "related":
-		p.match(
-			".", &subjectSetRel, ".", "includes", "(", "ctx", ".", "subject",
-			optional(","), ")", optional(","), ")",
-		)
-		p.addCheck(checkAllRelationsTypesHaveRelation(
-			&p.namespace, relation, subjectSetRel,
-		))
-	case "permits":
-		p.match(".", &subjectSetRel, "(", "ctx", ")", ")")
-		p.addCheck(checkAllRelationsTypesHaveRelation(
-			&p.namespace, relation, subjectSetRel,
-		))
-	default:
-		p.addFatal(verb, "expected 'related' or 'permits', got %q", verb)
+	body := simplifyExpression(p.parsePermissionExpressions(itemParenRight, depth-1, s.traverse(arg, relation)))
+	if body == nil {
 		return nil
 	}
-	p.addCheck(checkCurrentNamespaceHasRelation(&p.namespace, relation))
-	return &ast.TupleToSubjectSet{
-		Relation:                   relation.Val,
-		ComputedSubjectSetRelation: subjectSetRel,
+
+	// A traversal that only checks a single relation of the traversed subject
+	// sets is a tuple-to-subject-set rewrite.
+	if len(body.Children) == 1 {
+		if c, ok := body.Children[0].(*ast.ComputedSubjectSet); ok {
+			return &ast.TupleToSubjectSet{
+				Relation:                   relation.Val,
+				ComputedSubjectSetRelation: c.Relation,
+			}
+		}
+	}
+	return &ast.SubjectSetTraversal{
+		Relation: relation.Val,
+		Rewrite:  body,
 	}
 }
 
-func (p *parser) parseComputedSubjectSet(relation item) (rewrite ast.Child) {
-	if !p.match("(", "ctx", ".", "subject", ")") {
+func (p *parser) parseComputedSubjectSet(relation item, s scope) (rewrite ast.Child) {
+	if !p.match("(", "ctx", ".", "subject", optional(","), ")") {
 		return nil
 	}
-	p.addCheck(checkCurrentNamespaceHasRelation(&p.namespace, relation))
+	p.addCheck(s.hasRelation(p, relation))
 	return &ast.ComputedSubjectSet{Relation: relation.Val}
 }
 
@@ -47,6 +47,101 @@ var parserErrorTestCases = []struct{ name, input string }{
 		this.related.siblings.traverse(s => s.permits.edit(ctx)),
 	}
   }
+`},
+	{"unknown parent class", `
+class Document extends Resource {}
+`},
+	{"inheritance cycle", `
+class Folder extends Document {}
+class Document extends Folder {}
+`},
+	{"relation overridden by a permit", `
+class User implements Namespace {}
+class Resource implements Namespace {
+  related: {
+    owners: User[]
+  }
+}
+class Document extends Resource {
+  permits = {
+    owners: (ctx: Context) => this.related.owners.includes(ctx.subject),
+  }
+}
+`},
+	{"override breaks inherited permit", `
+class User implements Namespace {}
+class Folder implements Namespace {
+  related: {
+    viewers: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject),
+  }
+}
+class Resource implements Namespace {
+  related: {
+    parents: Folder[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.parents.traverse((p) => p.permits.view(ctx)),
+  }
+}
+class Document extends Resource {
+  related: {
+    parents: User[]
+  }
+}
+`},
+	{"undeclared relation after two traversals", `
+class User implements Namespace {}
+class Org implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Folder implements Namespace {
+  related: {
+    org: Org[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    parents: Folder[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.parents.traverse((p) =>
+      p.related.org.traverse((o) => o.permits.admin(ctx))),
+  }
+}
+`},
+	{"unreachable subject type", `
+class User implements Namespace {}
+class ServiceAccount implements Namespace {}
+class Document implements Namespace {
+  related: {
+    viewers: User[]
+  }
+  permits = {
+    view: (ctx: Context<User | ServiceAccount>) => this.related.viewers.includes(ctx.subject),
+  }
+}
+`},
+	{"unknown decorator", `
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    @internal
+    viewers: User[]
+  }
+}
+`},
+	{"decorator without name", `
+class User implements Namespace {}
+class Document implements Namespace {
+  related: {
+    @deprecated(editors)
+  }
+}
 `},
 	{"parser error", `
 class Resource implements Namespace {
@@ -157,6 +252,113 @@ class Resource implements Namespace {
       this.related.supervisors.traverse((role) => role.related.member.includes(ctx.subject)),
   };
 }
+`},
+	{"multi-hop traversals", `
+class User implements Namespace {}
+class Org implements Namespace {
+  related: {
+    admins: User[]
+    banned: User[]
+  }
+}
+class Folder implements Namespace {
+  related: {
+    org: Org[]
+    viewers: User[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    parents: Folder[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.parents.traverse((p) =>
+      p.related.viewers.includes(ctx.subject) ||
+      p.related.org.traverse((o) => o.related.admins.includes(ctx.subject) && !o.related.banned.includes(ctx.subject)),
+    ),
+    admin: (ctx: Context) => this.related.parents.traverse(p => p.related.org.traverse(o => o.related.admins.includes(ctx.subject))),
+  }
+}
+`},
+	{"subject types", `
+class User implements Namespace {}
+class ServiceAccount implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: (User | ServiceAccount)[]
+  }
+}
+class Document implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+    banned: User[]
+  }
+  permits = {
+    view: (ctx: Context<User | ServiceAccount>): boolean => this.related.viewers.includes(ctx.subject),
+    edit: (ctx: Context<SubjectSet<Group, "members">>) => this.permits.view(ctx),
+    comment: (ctx: Context<ServiceAccount>) => !this.related.banned.includes(ctx.subject),
+    share: (ctx: Context) => this.permits.view(ctx),
+  }
+}
+`},
+	{"annotations", `
+class User implements Namespace {}
+/** Not a relation. */
+class Document implements Namespace {
+  related: {
+    /** The users that can edit the content. */
+    editors: User[]
+    /**
+     * The users that could edit the content.
+     * Migrate them to editors.
+     */
+    @deprecated("use editors")
+    owners: User[]
+    // A line comment is not a doc comment.
+    @deprecated
+    viewers: User[]
+    /** Comments directly before the name win. */ @deprecated() /** Can comment. */ commenters: User[]
+  }
+  permits = {
+    /** Can edit content */
+    edit: (ctx: Context) => this.related.editors.includes(ctx.subject) || this.related.owners.includes(ctx.subject),
+    @deprecated('use edit')
+    write: (ctx: Context) => this.permits.edit(ctx),
+  }
+}
+`},
+	{"inheritance", `
+class User implements Namespace {}
+class Group implements Namespace {
+  related: {
+    members: User[]
+  }
+}
+class Resource implements Namespace {
+  related: {
+    owners: User[]
+    viewers: User[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject) || this.permits.edit(ctx),
+    edit: (ctx: Context) => this.related.owners.includes(ctx.subject),
+  }
+}
+class Folder extends Resource {
+  related: {
+    parents: Folder[]
+  }
+}
+class Document extends Folder implements Namespace {
+  related: {
+    viewers: (User | SubjectSet<Group, "members">)[]
+  }
+  permits = {
+    view: (ctx: Context) => this.related.viewers.includes(ctx.subject) ||
+      this.related.parents.traverse((p) => p.permits.view(ctx)),
+    comment: (ctx: Context) => this.permits.view(ctx),
+  }
+}
 `},
 }
 
@@ -3,7 +3,11 @@
 
 package schema
 
-import "github.com/ory/keto/internal/namespace/ast"
+import (
+	"fmt"
+
+	"github.com/ory/keto/internal/namespace/ast"
+)
 
 type (
 	namespaceQuery []namespace
@@ -96,35 +100,177 @@ func checkCurrentNamespaceHasRelation(current *namespace, relation item) typeChe
 	}
 }
 
-func checkAllRelationsTypesHaveRelation(current *namespace, relationType item, relation string) typeCheck {
+// checkTraversedNamespacesHaveRelation checks that all namespaces that are
+// reached by traversing the path of relations from the current namespace
+// declare the given relation.
+func checkTraversedNamespacesHaveRelation(current *namespace, path []item, relation item) typeCheck {
 	namespace := current.Name
 	return func(p *parser) {
-		recursiveCheckAllRelationsTypesHaveRelation(p, relationType, namespace, relationType.Val, relation, tupleToSubjectSetTypeCheckMaxDepth)
+		namespaces := []string{namespace}
+		for _, r := range path {
+			namespaces = p.traverseTypes(r, namespaces, r.Val)
+		}
+		for _, n := range namespaces {
+			if _, ok := p.query().findRelation(n, relation.Val); !ok {
+				p.addErr(relation, "relation %q was not declared in namespace %q",
+					relation.Val, n)
+			}
+		}
 	}
 }
 
-func recursiveCheckAllRelationsTypesHaveRelation(p *parser, item item, namespace string, relationType string, relation string, depth int) {
+// traverseTypes returns the namespaces of the types of the relation in all
+// given namespaces. Namespaces that don't declare the relation are skipped, as
+// that is reported by a separate check.
+func (p *parser) traverseTypes(item item, namespaces []string, relation string) (res []string) {
+	for _, n := range namespaces {
+		for _, t := range p.resolveTypes(item, n, relation, tupleToSubjectSetTypeCheckMaxDepth) {
+			if !containsString(res, t) {
+				res = append(res, t)
+			}
+		}
+	}
+	return res
+}
+
+// resolveTypes returns the namespaces of the types of the relation. The types
+// of subject sets are looked up recursively.
+func (p *parser) resolveTypes(item item, namespace, relation string, depth int) (namespaces []string) {
 	if depth < 0 {
 		p.addErr(item, "could not typecheck deeply nested SubjectSet further")
-		return
+		return nil
 	}
-	r, ok := namespaceQuery(p.namespaces).findRelation(namespace, relationType)
+	r, ok := p.query().findRelation(namespace, relation)
 	if !ok {
-		p.addErr(item, "relation %q was not declared in namespace %q",
-			relationType, namespace)
-		return
+		return nil
 	}
 	for _, t := range r.Types {
 		if t.Relation == "" {
-			if _, ok := p.query().findRelation(t.Namespace, relation); !ok {
-				p.addErr(item, "relation %q was not declared in namespace %q",
-					relation, t.Namespace)
-			}
+			namespaces = append(namespaces, t.Namespace)
 		} else {
-			// Type is a subject set, we need to recursively check if the type has
-			// the required relation.
-			recursiveCheckAllRelationsTypesHaveRelation(
-				p, item, t.Namespace, t.Relation, relation, depth-1)
+			namespaces = append(namespaces, p.resolveTypes(item, t.Namespace, t.Relation, depth-1)...)
+		}
+	}
+	return namespaces
+}
+
+// checkRewriteRelations checks that all relations that the rewrite refers to
+// are declared in the given namespaces, or in the namespaces that its
+// traversals reach.
+func (p *parser) checkRewriteRelations(item item, namespaces []string, child ast.Child) {
+	switch c := child.(type) {
+	case *ast.SubjectSetRewrite:
+		for _, c := range c.Children {
+			p.checkRewriteRelations(item, namespaces, c)
+		}
+	case *ast.InvertResult:
+		p.checkRewriteRelations(item, namespaces, c.Child)
+	case *ast.ComputedSubjectSet:
+		p.checkNamespacesHaveRelation(item, namespaces, c.Relation)
+	case *ast.TupleToSubjectSet:
+		p.checkNamespacesHaveRelation(item, namespaces, c.Relation)
+		p.checkNamespacesHaveRelation(item, p.traverseTypes(item, namespaces, c.Relation), c.ComputedSubjectSetRelation)
+	case *ast.SubjectSetTraversal:
+		p.checkNamespacesHaveRelation(item, namespaces, c.Relation)
+		p.checkRewriteRelations(item, p.traverseTypes(item, namespaces, c.Relation), c.Rewrite)
+	}
+}
+
+func (p *parser) checkNamespacesHaveRelation(item item, namespaces []string, relation string) {
+	for _, n := range namespaces {
+		if _, ok := p.query().findRelation(n, relation); !ok {
+			p.addErr(item, "relation %q was not declared in namespace %q", relation, n)
+		}
+	}
+}
+
+// checkPermitReachesSubjectTypes checks that every subject type of the permit
+// can be reached by at least one of its expressions.
+func checkPermitReachesSubjectTypes(current *namespace, permit item, subjectTypes []ast.RelationType) typeCheck {
+	namespace := current.Name
+	return func(p *parser) {
+		reachable, all := p.reachableSubjectTypes(permit, namespace, permit.Val, make(map[ast.RelationType]bool))
+		if all {
+			return
+		}
+	types:
+		for _, t := range subjectTypes {
+			for _, r := range reachable {
+				if r == t {
+					continue types
+				}
+			}
+			p.addErr(permit, "permit %q applies to subject type %s, but none of its expressions can reach it",
+				permit.Val, formatRelationType(t))
+		}
+	}
+}
+
+// reachableSubjectTypes returns the subject types that can be members of the
+// relation, directly, through subject sets, or through rewrites. If all is
+// true, every subject type can be a member, because the relation inverts the
+// result of another one.
+func (p *parser) reachableSubjectTypes(item item, namespace, relation string, visited map[ast.RelationType]bool) (types []ast.RelationType, all bool) {
+	key := ast.RelationType{Namespace: namespace, Relation: relation}
+	if visited[key] {
+		return nil, false
+	}
+	visited[key] = true
+
+	r, ok := p.query().findRelation(namespace, relation)
+	if !ok {
+		return nil, false
+	}
+	if r.SubjectSetRewrite == nil {
+		for _, t := range r.Types {
+			types = append(types, t)
+			if t.Relation != "" {
+				tt, a := p.reachableSubjectTypes(item, t.Namespace, t.Relation, visited)
+				types, all = append(types, tt...), all || a
+			}
+		}
+		return types, all
+	}
+
+	var walk func(namespace string, child ast.Child)
+	walk = func(namespace string, child ast.Child) {
+		switch c := child.(type) {
+		case *ast.SubjectSetRewrite:
+			for _, c := range c.Children {
+				walk(namespace, c)
+			}
+		case *ast.InvertResult:
+			all = true
+		case *ast.ComputedSubjectSet:
+			tt, a := p.reachableSubjectTypes(item, namespace, c.Relation, visited)
+			types, all = append(types, tt...), all || a
+		case *ast.TupleToSubjectSet:
+			for _, n := range p.traverseTypes(item, []string{namespace}, c.Relation) {
+				tt, a := p.reachableSubjectTypes(item, n, c.ComputedSubjectSetRelation, visited)
+				types, all = append(types, tt...), all || a
+			}
+		case *ast.SubjectSetTraversal:
+			for _, n := range p.traverseTypes(item, []string{namespace}, c.Relation) {
+				walk(n, c.Rewrite)
+			}
+		}
+	}
+	walk(namespace, r.SubjectSetRewrite)
+	return types, all
+}
+
+func formatRelationType(t ast.RelationType) string {
+	if t.Relation == "" {
+		return t.Namespace
+	}
+	return fmt.Sprintf("SubjectSet<%s, %q>", t.Namespace, t.Relation)
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
 		}
 	}
+	return false
 }
@@ -0,0 +1,26 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/ory/herodot"
+)
+
+// GetAsOfFromQuery parses the optional 'as_of' query parameter, an RFC 3339
+// timestamp.
+func GetAsOfFromQuery(q url.Values) (*time.Time, error) {
+	if !q.Has("as_of") {
+		return nil, nil
+	}
+
+	asOf, err := time.Parse(time.RFC3339Nano, q.Get("as_of"))
+	if err != nil {
+		return nil, herodot.ErrBadRequest.WithErrorf("unable to parse 'as_of' query parameter as RFC 3339 timestamp: %s", err)
+	}
+
+	return &asOf, nil
+}
@@ -0,0 +1,21 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"time"
+)
+
+type detachedContext struct{ context.Context }
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// DetachedContext keeps the values of ctx, but is not canceled with it, e.g.
+// for work that must outlive the request.
+func DetachedContext(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
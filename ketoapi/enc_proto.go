@@ -4,9 +4,12 @@
 package ketoapi
 
 import (
+	"github.com/ory/herodot"
 	"github.com/ory/x/pointerx"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	opl "github.com/ory/keto/proto/ory/keto/opl/v1alpha1"
 	rts "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
 )
 
@@ -116,6 +119,18 @@ func (q *RelationQuery) ToProto() *rts.RelationQuery {
 	return res
 }
 
+func (p *Precondition) ToProto() *rts.Precondition {
+	switch {
+	case p.TupleExists != nil:
+		return &rts.Precondition{Condition: &rts.Precondition_TupleExists{TupleExists: p.TupleExists.ToProto()}}
+	case p.TupleNotExists != nil:
+		return &rts.Precondition{Condition: &rts.Precondition_TupleNotExists{TupleNotExists: p.TupleNotExists.ToProto()}}
+	case p.NoMatch != nil:
+		return &rts.Precondition{Condition: &rts.Precondition_NoMatch{NoMatch: p.NoMatch.ToProto()}}
+	}
+	return &rts.Precondition{}
+}
+
 func (t *Tree[NodeT]) ToProto() *rts.SubjectTree {
 	res := &rts.SubjectTree{
 		NodeType: t.Type.ToProto(),
@@ -186,3 +201,268 @@ func (TreeNodeType) FromProto(pt rts.NodeType) TreeNodeType {
 	}
 	return TreeNodeUnspecified
 }
+
+func (r *DescribeNamespacesResponse) ToProto() *rts.DescribeNamespacesResponse {
+	res := &rts.DescribeNamespacesResponse{
+		Namespaces: make([]*rts.NamespaceSchema, len(r.Namespaces)),
+	}
+	for i, n := range r.Namespaces {
+		res.Namespaces[i] = n.ToProto()
+	}
+	return res
+}
+
+func (n *NamespaceSchema) ToProto() *rts.NamespaceSchema {
+	res := &rts.NamespaceSchema{
+		Name:      n.Name,
+		Relations: make([]*rts.NamespaceRelation, len(n.Relations)),
+	}
+	for i, r := range n.Relations {
+		res.Relations[i] = r.ToProto()
+	}
+	return res
+}
+
+func (r *NamespaceRelation) ToProto() *rts.NamespaceRelation {
+	res := &rts.NamespaceRelation{
+		Name:              r.Name,
+		Types:             make([]*rts.RelationType, len(r.Types)),
+		Description:       r.Description,
+		Deprecated:        r.Deprecated,
+		DeprecationReason: r.DeprecationReason,
+		SubjectTypes:      make([]*rts.RelationType, len(r.SubjectTypes)),
+	}
+	for i, t := range r.Types {
+		res.Types[i] = &rts.RelationType{
+			Namespace: t.Namespace,
+			Relation:  t.Relation,
+		}
+	}
+	for i, t := range r.SubjectTypes {
+		res.SubjectTypes[i] = &rts.RelationType{
+			Namespace: t.Namespace,
+			Relation:  t.Relation,
+		}
+	}
+	if r.Rewrite != nil {
+		res.Rewrite = r.Rewrite.ToProto()
+	}
+	return res
+}
+
+func (n *RewriteNode) ToProto() *rts.RewriteNode {
+	res := &rts.RewriteNode{
+		Type:                       n.Type.ToRewriteProto(),
+		Relation:                   n.Relation,
+		ComputedSubjectSetRelation: n.ComputedSubjectSetRelation,
+		Children:                   make([]*rts.RewriteNode, len(n.Children)),
+	}
+	for i, c := range n.Children {
+		res.Children[i] = c.ToProto()
+	}
+	return res
+}
+
+func (t TreeNodeType) ToRewriteProto() rts.RewriteNodeType {
+	switch t {
+	case TreeNodeUnion:
+		return rts.RewriteNodeType_REWRITE_NODE_TYPE_UNION
+	case TreeNodeIntersection:
+		return rts.RewriteNodeType_REWRITE_NODE_TYPE_INTERSECTION
+	case TreeNodeComputedSubjectSet:
+		return rts.RewriteNodeType_REWRITE_NODE_TYPE_COMPUTED_SUBJECT_SET
+	case TreeNodeTupleToSubjectSet:
+		return rts.RewriteNodeType_REWRITE_NODE_TYPE_TUPLE_TO_SUBJECT_SET
+	case TreeNodeNot:
+		return rts.RewriteNodeType_REWRITE_NODE_TYPE_NOT
+	}
+	return rts.RewriteNodeType_REWRITE_NODE_TYPE_UNSPECIFIED
+}
+
+func (v *SchemaVersion) ToProto() *opl.SchemaVersion {
+	return &opl.SchemaVersion{
+		Version:   int64(v.Version),
+		Content:   []byte(v.Content),
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
+
+func (e *ParseError) ToProto() *opl.ParseError {
+	return &opl.ParseError{
+		Message: e.Message,
+		Start:   &opl.SourcePosition{Line: uint32(e.Start.Line), Column: uint32(e.Start.Col)},
+		End:     &opl.SourcePosition{Line: uint32(e.End.Line), Column: uint32(e.End.Col)},
+	}
+}
+
+func (s *SchemaStatus) ToProto() *rts.SchemaStatus {
+	res := &rts.SchemaStatus{
+		Hash:      s.Hash,
+		Version:   int64(s.Version),
+		LastError: s.LastError,
+	}
+	if s.LoadedAt != nil {
+		res.LoadedAt = timestamppb.New(*s.LoadedAt)
+	}
+	if s.LastErrorAt != nil {
+		res.LastErrorAt = timestamppb.New(*s.LastErrorAt)
+	}
+	return res
+}
+
+func (r *EvaluateOPLRequest) FromProto(req *opl.EvaluateRequest) (*EvaluateOPLRequest, error) {
+	r.Schema = string(req.Content)
+	r.MaxDepth = int(req.MaxDepth)
+	for _, t := range req.RelationTuples {
+		rt, err := (&RelationTuple{}).FromDataProvider(t)
+		if err != nil {
+			return nil, err
+		}
+		r.RelationTuples = append(r.RelationTuples, rt)
+	}
+	for _, t := range req.Checks {
+		rt, err := (&RelationTuple{}).FromDataProvider(t)
+		if err != nil {
+			return nil, err
+		}
+		r.Checks = append(r.Checks, rt)
+	}
+	for _, s := range req.Expands {
+		r.Expands = append(r.Expands, &SubjectSet{
+			Namespace: s.Namespace,
+			Object:    s.Object,
+			Relation:  s.Relation,
+		})
+	}
+	return r, nil
+}
+
+func (r *EvaluateOPLResponse) ToProto() *opl.EvaluateResponse {
+	res := &opl.EvaluateResponse{
+		ParseErrors: make([]*opl.ParseError, len(r.Errors)),
+		Checks:      make([]*opl.CheckResult, len(r.Checks)),
+		Expands:     make([]*opl.ExpandResult, len(r.Expands)),
+	}
+	for i, e := range r.Errors {
+		res.ParseErrors[i] = e.ToProto()
+	}
+	for i, c := range r.Checks {
+		res.Checks[i] = &opl.CheckResult{
+			RelationTuple: c.RelationTuple.ToProto(),
+			Allowed:       c.Allowed,
+			Error:         c.Error,
+		}
+		if c.Tree != nil {
+			res.Checks[i].Tree = c.Tree.ToProto()
+		}
+	}
+	for i, e := range r.Expands {
+		res.Expands[i] = &opl.ExpandResult{
+			SubjectSet: &rts.SubjectSet{
+				Namespace: e.SubjectSet.Namespace,
+				Object:    e.SubjectSet.Object,
+				Relation:  e.SubjectSet.Relation,
+			},
+			Error: e.Error,
+		}
+		if e.Tree != nil {
+			res.Expands[i].Tree = e.Tree.ToProto()
+		}
+	}
+	return res
+}
+
+func (r *SimulateCheckRequest) FromProto(req *rts.SimulateRequest) (*SimulateCheckRequest, error) {
+	r.MaxDepth = int(req.MaxDepth)
+	for _, d := range req.RelationTupleDeltas {
+		rt, err := (&RelationTuple{}).FromDataProvider(d.RelationTuple)
+		if err != nil {
+			return nil, err
+		}
+		var action PatchAction
+		switch d.Action {
+		case rts.RelationTupleDelta_ACTION_INSERT:
+			action = ActionInsert
+		case rts.RelationTupleDelta_ACTION_DELETE:
+			action = ActionDelete
+		default:
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("unknown action %s", d.Action))
+		}
+		r.Deltas = append(r.Deltas, &PatchDelta{Action: action, RelationTuple: rt})
+	}
+	for _, t := range req.Checks {
+		rt, err := (&RelationTuple{}).FromDataProvider(t)
+		if err != nil {
+			return nil, err
+		}
+		r.Checks = append(r.Checks, rt)
+	}
+	return r, nil
+}
+
+func (r *SimulateCheckResponse) ToProto() *rts.SimulateResponse {
+	res := &rts.SimulateResponse{Flipped: make([]*rts.SimulatedCheck, len(r.Flipped))}
+	for i, c := range r.Flipped {
+		res.Flipped[i] = &rts.SimulatedCheck{
+			Tuple:         c.RelationTuple.ToProto(),
+			AllowedBefore: c.AllowedBefore,
+			AllowedAfter:  c.AllowedAfter,
+		}
+	}
+	return res
+}
+
+func (c *RelationTupleChanges) ToProto() *rts.WatchResponse {
+	return &rts.WatchResponse{
+		RelationTupleDeltas: deltasToProto(c.Deltas),
+		Cursor:              c.Cursor,
+		CommitTime:          timestamppb.New(c.CommitTime),
+	}
+}
+
+func (e *AuditEntry) ToProto() *rts.AuditEntry {
+	return &rts.AuditEntry{
+		Time:                timestamppb.New(e.Time),
+		Operation:           e.Operation,
+		Actor:               e.Actor,
+		ActorSource:         e.ActorSource,
+		RequestId:           e.RequestID,
+		RelationTupleDeltas: deltasToProto(e.Deltas),
+	}
+}
+
+func (e *AuditEntry) FromProto(pe *rts.AuditEntry) (*AuditEntry, error) {
+	e.Time = pe.Time.AsTime()
+	e.Operation = pe.Operation
+	e.Actor = pe.Actor
+	e.ActorSource = pe.ActorSource
+	e.RequestID = pe.RequestId
+	e.Deltas = make([]*PatchDelta, len(pe.RelationTupleDeltas))
+	for i, d := range pe.RelationTupleDeltas {
+		rt, err := (&RelationTuple{}).FromDataProvider(d.RelationTuple)
+		if err != nil {
+			return nil, err
+		}
+		action := ActionInsert
+		if d.Action == rts.RelationTupleDelta_ACTION_DELETE {
+			action = ActionDelete
+		}
+		e.Deltas[i] = &PatchDelta{Action: action, RelationTuple: rt}
+	}
+	return e, nil
+}
+
+func deltasToProto(deltas []*PatchDelta) []*rts.RelationTupleDelta {
+	res := make([]*rts.RelationTupleDelta, len(deltas))
+	for i, d := range deltas {
+		action := rts.RelationTupleDelta_ACTION_INSERT
+		if d.Action == ActionDelete {
+			action = rts.RelationTupleDelta_ACTION_DELETE
+		}
+		res[i] = &rts.RelationTupleDelta{
+			Action:        action,
+			RelationTuple: d.RelationTuple.ToProto(),
+		}
+	}
+	return res
+}
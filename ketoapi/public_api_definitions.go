@@ -6,6 +6,7 @@ package ketoapi
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/ory/herodot"
 	"github.com/pkg/errors"
@@ -21,6 +22,8 @@ var (
 	ErrNilSubject        = herodot.ErrBadRequest.WithError("subject is not allowed to be nil").WithDebug("Please provide a subject.")
 	ErrIncompleteTuple   = herodot.ErrBadRequest.WithError(`incomplete tuple, provide "namespace", "object", "relation", and a subject`)
 	ErrUnknownNodeType   = errors.New("unknown node type")
+
+	ErrInvalidPrecondition = herodot.ErrBadRequest.WithError(`exactly one of "tuple_exists", "tuple_not_exists", or "no_match" has to be provided`)
 )
 
 // swagger:model namespace
@@ -112,6 +115,77 @@ type PatchDelta struct {
 	RelationTuple *RelationTuple `json:"relation_tuple"`
 }
 
+// Precondition of a relationship patch
+//
+// Exactly one of the conditions has to be set.
+//
+// swagger:model relationshipPrecondition
+type Precondition struct {
+	// The relationship must exist.
+	TupleExists *RelationTuple `json:"tuple_exists,omitempty"`
+
+	// The relationship must not exist.
+	TupleNotExists *RelationTuple `json:"tuple_not_exists,omitempty"`
+
+	// No relationship must match the query.
+	NoMatch *RelationQuery `json:"no_match,omitempty"`
+}
+
+// Payload for patching relationships with preconditions
+//
+// swagger:model relationshipPatchWithPreconditions
+type PatchRequest struct {
+	// The changes to apply in one transaction.
+	//
+	// required: true
+	Deltas []*PatchDelta `json:"deltas"`
+
+	// The conditions that must hold for the changes to be applied. They are
+	// checked in the same transaction. If any of them does not hold, no
+	// change is applied.
+	Preconditions []*Precondition `json:"preconditions,omitempty"`
+}
+
+// Delete Object Result
+//
+// swagger:model deleteObjectResult
+type DeleteObjectResponse struct {
+	// The number of deleted relationships.
+	//
+	// required: true
+	Deleted int `json:"deleted"`
+}
+
+// Rename Object Request Body
+//
+// swagger:model renameObjectBody
+type RenameObjectRequest struct {
+	// Namespace of the object.
+	//
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// The object to rename.
+	//
+	// required: true
+	Object string `json:"object"`
+
+	// The new ID of the object. It must not have relationships yet.
+	//
+	// required: true
+	NewObject string `json:"new_object"`
+}
+
+// Rename Object Result
+//
+// swagger:model renameObjectResult
+type RenameObjectResponse struct {
+	// The number of moved relationships.
+	//
+	// required: true
+	Renamed int `json:"renamed"`
+}
+
 // swagger:enum PatchAction
 type PatchAction string
 
@@ -145,6 +219,94 @@ type GetNamespacesResponse struct {
 	Namespaces []Namespace `json:"namespaces"`
 }
 
+// Namespace Schema List
+//
+// swagger:model namespaceSchemas
+type DescribeNamespacesResponse struct {
+	Namespaces []*NamespaceSchema `json:"namespaces"`
+}
+
+// swagger:model namespaceSchema
+type NamespaceSchema struct {
+	// Name of the namespace.
+	//
+	// required: true
+	Name string `json:"name"`
+
+	// The relations and permits of the namespace.
+	//
+	// required: true
+	Relations []*NamespaceRelation `json:"relations"`
+}
+
+// A relation or permit of a namespace.
+//
+// swagger:model namespaceRelation
+type NamespaceRelation struct {
+	// Name of the relation or permit.
+	//
+	// required: true
+	Name string `json:"name"`
+
+	// The subject types that relationships of this relation may have. Empty
+	// for permits.
+	Types []*RelationType `json:"types,omitempty"`
+
+	// The rewrite of a permit. Not set for relations.
+	Rewrite *RewriteNode `json:"rewrite,omitempty"`
+
+	// The doc comment of the relation or permit.
+	Description string `json:"description,omitempty"`
+
+	// Whether the relation or permit is deprecated. Writing relationships of
+	// a deprecated relation emits a warning.
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// Why the relation or permit is deprecated, e.g. what to use instead.
+	DeprecationReason string `json:"deprecation_reason,omitempty"`
+
+	// The subject types that a permit applies to. Empty for relations, and
+	// for permits that apply to all subjects.
+	SubjectTypes []*RelationType `json:"subject_types,omitempty"`
+}
+
+// A subject type of a relation.
+//
+// swagger:model relationType
+type RelationType struct {
+	// Namespace of the subject type
+	//
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// Relation of the subject type, if it is a subject set
+	Relation string `json:"relation,omitempty"`
+}
+
+// A node of a permit's rewrite.
+//
+// swagger:model rewriteNode
+type RewriteNode struct {
+	// The type of the node. One of union, intersection, not,
+	// computed_subject_set, or tuple_to_subject_set.
+	//
+	// required: true
+	Type TreeNodeType `json:"type"`
+
+	// The relation of a computed subject set, or the relation to traverse of a
+	// tuple to subject set.
+	Relation string `json:"relation,omitempty"`
+
+	// The relation that is checked on the traversed subject sets of a tuple to
+	// subject set. It is empty if the traversed subject sets are checked with
+	// the rewrite in children instead.
+	ComputedSubjectSetRelation string `json:"computed_subject_set_relation,omitempty"`
+
+	// The children of a union, intersection, or negation, or the rewrite that
+	// is checked on the traversed subject sets of a tuple to subject set.
+	Children []*RewriteNode `json:"children,omitempty"`
+}
+
 func (r *RelationTuple) ToLoggerFields() logrus.Fields {
 	fields := make(logrus.Fields, 7)
 	q := r.ToURLQuery()
@@ -161,6 +323,18 @@ func (r *RelationTuple) Validate() error {
 	return nil
 }
 
+func (p *Precondition) Validate() error {
+	switch {
+	case p.TupleExists != nil && p.TupleNotExists == nil && p.NoMatch == nil:
+		return p.TupleExists.Validate()
+	case p.TupleNotExists != nil && p.TupleExists == nil && p.NoMatch == nil:
+		return p.TupleNotExists.Validate()
+	case p.NoMatch != nil && p.TupleExists == nil && p.TupleNotExists == nil:
+		return nil
+	}
+	return errors.WithStack(ErrInvalidPrecondition)
+}
+
 // swagger:enum ExpandNodeType
 type ExpandNodeType TreeNodeType
 
@@ -251,3 +425,345 @@ type CheckOPLSyntaxResponse struct {
 	// required: false
 	Errors []*ParseError `json:"errors,omitempty"`
 }
+
+// A stored version of the OPL schema.
+//
+// swagger:model schemaVersion
+type SchemaVersion struct {
+	// The version number, starting at 1.
+	//
+	// required: true
+	Version int `json:"version"`
+
+	// The OPL content. It is omitted when listing the versions.
+	Content string `json:"content,omitempty"`
+
+	// The time the version was stored.
+	//
+	// required: true
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WriteSchemaResponse represents the response for an OPL schema write request.
+//
+// swagger:model writeSchemaResult
+type WriteSchemaResponse struct {
+	// The stored version. It is omitted if the content could not be parsed.
+	Version *SchemaVersion `json:"version,omitempty"`
+
+	// The list of syntax errors. The content is only stored if there are none.
+	Errors []*ParseError `json:"errors,omitempty"`
+}
+
+// Schema Version List
+//
+// swagger:model schemaVersions
+type ListSchemaVersionsResponse struct {
+	// All stored versions, the active (latest) one first.
+	//
+	// required: true
+	Versions []*SchemaVersion `json:"versions"`
+}
+
+// The status of the OPL schema that is loaded by an instance.
+//
+// swagger:model schemaStatus
+type SchemaStatus struct {
+	// The SHA-256 hash of the active schema. It is omitted if no schema is
+	// loaded.
+	Hash string `json:"hash,omitempty"`
+
+	// The version of the active schema, if it was loaded from the database.
+	Version int `json:"version,omitempty"`
+
+	// The time the active schema was loaded.
+	LoadedAt *time.Time `json:"loaded_at,omitempty"`
+
+	// The error of the last failed load. If it is set, the active schema is
+	// stale.
+	LastError string `json:"last_error,omitempty"`
+
+	// The time of the last failed load.
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// The version of an instance, and the status of its OPL schema.
+//
+// swagger:model instanceVersion
+type VersionResponse struct {
+	// The version of the instance.
+	//
+	// required: true
+	Version string `json:"version"`
+
+	// The status of the OPL schema of the instance.
+	//
+	// required: true
+	Schema *SchemaStatus `json:"schema"`
+}
+
+// swagger:model rollbackSchemaBody
+type RollbackSchemaRequest struct {
+	// The version to roll back to.
+	//
+	// required: true
+	Version int `json:"version"`
+}
+
+// EvaluateOPLRequest is the request to evaluate an OPL schema in the
+// playground.
+//
+// swagger:model evaluateOplBody
+type EvaluateOPLRequest struct {
+	// The OPL content.
+	//
+	// required: true
+	Schema string `json:"schema"`
+
+	// The relationships to evaluate against.
+	RelationTuples []*RelationTuple `json:"relation_tuples"`
+
+	// The relationships to check.
+	Checks []*RelationTuple `json:"checks"`
+
+	// The subject sets to expand.
+	Expands []*SubjectSet `json:"expands"`
+
+	// The maximum depth of the checks and expands. Falls back to the
+	// configured maximum if unset or larger.
+	MaxDepth int `json:"max-depth,omitempty"`
+}
+
+// EvaluateOPLResponse represents the response for an OPL playground request.
+type EvaluateOPLResponse struct {
+	// Propagate all struct changes to `swaggerOnlyEvaluateOPLResponse` as well.
+	// The list of syntax errors. Nothing is evaluated if there are any.
+	Errors []*ParseError `json:"errors,omitempty"`
+
+	// The results of the checks, in the order of the request.
+	Checks []*PlaygroundCheckResult `json:"checks"`
+
+	// The results of the expands, in the order of the request.
+	Expands []*PlaygroundExpandResult `json:"expands"`
+}
+
+// The result of a check in the OPL playground.
+type PlaygroundCheckResult struct {
+	// Propagate all struct changes to `swaggerOnlyPlaygroundCheckResult` as well.
+	// The checked relationship.
+	//
+	// required: true
+	RelationTuple *RelationTuple `json:"relation_tuple"`
+
+	// Whether the relationship is allowed.
+	//
+	// required: true
+	Allowed bool `json:"allowed"`
+
+	// The check tree that explains the decision.
+	Tree *Tree[*RelationTuple] `json:"tree,omitempty"`
+
+	// Set if the check could not be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// The result of an expand in the OPL playground.
+type PlaygroundExpandResult struct {
+	// Propagate all struct changes to `swaggerOnlyPlaygroundExpandResult` as well.
+	// The expanded subject set.
+	//
+	// required: true
+	SubjectSet *SubjectSet `json:"subject_set"`
+
+	// The expand tree. It is omitted if there are no relationships.
+	Tree *Tree[*RelationTuple] `json:"tree,omitempty"`
+
+	// Set if the expand could not be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// The playground results contain generic trees, so they also need manual
+// instantiations for the OpenAPI spec.
+
+// EvaluateOPLResponse represents the response for an OPL playground request.
+//
+// swagger:model evaluateOplResult
+type swaggerOnlyEvaluateOPLResponse struct { // nolint
+	// The list of syntax errors. Nothing is evaluated if there are any.
+	Errors []*ParseError `json:"errors,omitempty"`
+
+	// The results of the checks, in the order of the request.
+	Checks []*swaggerOnlyPlaygroundCheckResult `json:"checks"`
+
+	// The results of the expands, in the order of the request.
+	Expands []*swaggerOnlyPlaygroundExpandResult `json:"expands"`
+}
+
+// The result of a check in the OPL playground.
+//
+// swagger:model playgroundCheckResult
+type swaggerOnlyPlaygroundCheckResult struct { // nolint
+	// The checked relationship.
+	//
+	// required: true
+	RelationTuple *RelationTuple `json:"relation_tuple"`
+
+	// Whether the relationship is allowed.
+	//
+	// required: true
+	Allowed bool `json:"allowed"`
+
+	// The check tree that explains the decision.
+	Tree *swaggerOnlyExpandTree `json:"tree,omitempty"`
+
+	// Set if the check could not be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// The result of an expand in the OPL playground.
+//
+// swagger:model playgroundExpandResult
+type swaggerOnlyPlaygroundExpandResult struct { // nolint
+	// The expanded subject set.
+	//
+	// required: true
+	SubjectSet *SubjectSet `json:"subject_set"`
+
+	// The expand tree. It is omitted if there are no relationships.
+	Tree *swaggerOnlyExpandTree `json:"tree,omitempty"`
+
+	// Set if the expand could not be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// SimulateCheckRequest is the request to evaluate checks with hypothetical
+// changes to the relationships.
+//
+// swagger:model simulateCheckBody
+type SimulateCheckRequest struct {
+	// The hypothetical changes to the relationships.
+	//
+	// required: true
+	Deltas []*PatchDelta `json:"deltas"`
+
+	// The relationships to check.
+	//
+	// required: true
+	Checks []*RelationTuple `json:"checks"`
+
+	// The maximum depth of the checks. Falls back to the configured maximum
+	// if unset or larger.
+	MaxDepth int `json:"max-depth,omitempty"`
+}
+
+// SimulateCheckResponse represents the response for a check simulation.
+//
+// swagger:model simulateCheckResult
+type SimulateCheckResponse struct {
+	// The checks whose decision changes, in the order of the request.
+	//
+	// required: true
+	Flipped []*SimulatedCheck `json:"flipped"`
+}
+
+// A check whose decision changes with the simulated changes.
+//
+// swagger:model simulatedCheck
+type SimulatedCheck struct {
+	// The checked relationship.
+	//
+	// required: true
+	RelationTuple *RelationTuple `json:"relation_tuple"`
+
+	// Whether the check is allowed without the changes.
+	//
+	// required: true
+	AllowedBefore bool `json:"allowed_before"`
+
+	// Whether the check is allowed with the changes.
+	//
+	// required: true
+	AllowedAfter bool `json:"allowed_after"`
+}
+
+// The changes of one committed transaction.
+//
+// swagger:model relationshipChanges
+type RelationTupleChanges struct {
+	// The inserted and deleted relationships, in the order they were written.
+	//
+	// required: true
+	Deltas []*PatchDelta `json:"deltas"`
+
+	// The cursor to resume watching after this transaction.
+	//
+	// required: true
+	Cursor string `json:"cursor"`
+
+	// The time the transaction was committed.
+	//
+	// required: true
+	CommitTime time.Time `json:"commit_time"`
+}
+
+// Relationship Changes
+//
+// swagger:model watchRelationshipsResponse
+type WatchResponse struct {
+	// The committed transactions, oldest first.
+	//
+	// required: true
+	Changes []*RelationTupleChanges `json:"changes"`
+
+	// The cursor to continue watching after the returned changes.
+	//
+	// required: true
+	Cursor string `json:"cursor"`
+}
+
+// One write of relationships.
+//
+// swagger:model auditEntry
+type AuditEntry struct {
+	// The time of the write.
+	//
+	// required: true
+	Time time.Time `json:"time"`
+
+	// The kind of write, one of "write", "delete", "delete_all" or "transact".
+	//
+	// required: true
+	Operation string `json:"operation"`
+
+	// Who sent the write, if known.
+	Actor string `json:"actor,omitempty"`
+
+	// Where the actor was taken from, one of "header", "metadata" or "mtls".
+	ActorSource string `json:"actor_source,omitempty"`
+
+	// The ID of the request that sent the write.
+	//
+	// required: true
+	RequestID string `json:"request_id"`
+
+	// The relationships that were inserted and deleted by the write.
+	//
+	// required: true
+	Deltas []*PatchDelta `json:"deltas"`
+}
+
+// Audit Log Entries
+//
+// swagger:model auditEntries
+type ListAuditEntriesResponse struct {
+	// The audit log entries, the latest first.
+	//
+	// required: true
+	Entries []*AuditEntry `json:"entries"`
+
+	// The token required to get the next page. If this is the last page, the
+	// token will be the empty string.
+	//
+	// required: true
+	NextPageToken string `json:"next_page_token"`
+}
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: ory/keto/opl/v1alpha1/playground_service.proto
+
+package opl
+
+import (
+	v1alpha2 "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EvaluateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The OPL content.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The relationships to evaluate against.
+	RelationTuples []*v1alpha2.RelationTuple `protobuf:"bytes,2,rep,name=relation_tuples,json=relationTuples,proto3" json:"relation_tuples,omitempty"`
+	// The relationships to check.
+	Checks []*v1alpha2.RelationTuple `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	// The subject sets to expand.
+	Expands []*v1alpha2.SubjectSet `protobuf:"bytes,4,rep,name=expands,proto3" json:"expands,omitempty"`
+	// The maximum depth of the checks and expands. Falls back to the configured
+	// maximum if unset or larger.
+	MaxDepth int32 `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (x *EvaluateRequest) Reset() {
+	*x = EvaluateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateRequest) ProtoMessage() {}
+
+func (x *EvaluateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EvaluateRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetRelationTuples() []*v1alpha2.RelationTuple {
+	if x != nil {
+		return x.RelationTuples
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetChecks() []*v1alpha2.RelationTuple {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetExpands() []*v1alpha2.SubjectSet {
+	if x != nil {
+		return x.Expands
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type EvaluateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The errors found while parsing the content. Nothing is evaluated if
+	// there are any.
+	ParseErrors []*ParseError `protobuf:"bytes,1,rep,name=parse_errors,json=parseErrors,proto3" json:"parse_errors,omitempty"`
+	// The results of the checks, in the order of the request.
+	Checks []*CheckResult `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	// The results of the expands, in the order of the request.
+	Expands []*ExpandResult `protobuf:"bytes,3,rep,name=expands,proto3" json:"expands,omitempty"`
+}
+
+func (x *EvaluateResponse) Reset() {
+	*x = EvaluateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResponse) ProtoMessage() {}
+
+func (x *EvaluateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EvaluateResponse) GetParseErrors() []*ParseError {
+	if x != nil {
+		return x.ParseErrors
+	}
+	return nil
+}
+
+func (x *EvaluateResponse) GetChecks() []*CheckResult {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *EvaluateResponse) GetExpands() []*ExpandResult {
+	if x != nil {
+		return x.Expands
+	}
+	return nil
+}
+
+type CheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The checked relationship.
+	RelationTuple *v1alpha2.RelationTuple `protobuf:"bytes,1,opt,name=relation_tuple,json=relationTuple,proto3" json:"relation_tuple,omitempty"`
+	Allowed       bool                    `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// The check tree that explains the decision.
+	Tree *v1alpha2.SubjectTree `protobuf:"bytes,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	// Set if the check could not be evaluated.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CheckResult) Reset() {
+	*x = CheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResult) ProtoMessage() {}
+
+func (x *CheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResult.ProtoReflect.Descriptor instead.
+func (*CheckResult) Descriptor() ([]byte, []int) {
+	return file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckResult) GetRelationTuple() *v1alpha2.RelationTuple {
+	if x != nil {
+		return x.RelationTuple
+	}
+	return nil
+}
+
+func (x *CheckResult) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckResult) GetTree() *v1alpha2.SubjectTree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *CheckResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExpandResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The expanded subject set.
+	SubjectSet *v1alpha2.SubjectSet `protobuf:"bytes,1,opt,name=subject_set,json=subjectSet,proto3" json:"subject_set,omitempty"`
+	// The expand tree. Not set if there are no relationships.
+	Tree *v1alpha2.SubjectTree `protobuf:"bytes,2,opt,name=tree,proto3" json:"tree,omitempty"`
+	// Set if the expand could not be evaluated.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ExpandResult) Reset() {
+	*x = ExpandResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandResult) ProtoMessage() {}
+
+func (x *ExpandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandResult.ProtoReflect.Descriptor instead.
+func (*ExpandResult) Descriptor() ([]byte, []int) {
+	return file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpandResult) GetSubjectSet() *v1alpha2.SubjectSet {
+	if x != nil {
+		return x.SubjectSet
+	}
+	return nil
+}
+
+func (x *ExpandResult) GetTree() *v1alpha2.SubjectTree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *ExpandResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_ory_keto_opl_v1alpha1_playground_service_proto protoreflect.FileDescriptor
+
+var file_ory_keto_opl_v1alpha1_playground_service_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x6f, 0x72, 0x79, 0x2f, 0x6b, 0x65, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x6c, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x15, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x6f, 0x70, 0x6c, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x2a, 0x6f, 0x72, 0x79, 0x2f, 0x6b, 0x65, 0x74,
+	0x6f, 0x2f, 0x6f, 0x70, 0x6c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73,
+	0x79, 0x6e, 0x74, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x6f, 0x72, 0x79, 0x2f, 0x6b, 0x65, 0x74, 0x6f, 0x2f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x37, 0x6f, 0x72, 0x79,
+	0x2f, 0x6b, 0x65, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x75, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x0f, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72,
+	0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x48, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x32, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b,
+	0x65, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70,
+	0x6c, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2e, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x07, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xd3, 0x01,
+	0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b,
+	0x65, 0x74, 0x6f, 0x2e, 0x6f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b,
+	0x65, 0x74, 0x6f, 0x2e, 0x6f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f,
+	0x2e, 0x6f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x65, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x75, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72,
+	0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x0d, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xb8, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74,
+	0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x74, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x32, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x70, 0x0a, 0x11, 0x50,
+	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5b, 0x0a, 0x08, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6f,
+	0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x6f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e,
+	0x6f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x99, 0x01,
+	0x0a, 0x18, 0x73, 0x68, 0x2e, 0x6f, 0x72, 0x79, 0x2e, 0x6b, 0x65, 0x74, 0x6f, 0x2e, 0x6f, 0x70,
+	0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x16, 0x50, 0x6c, 0x61, 0x79,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x72, 0x79, 0x2f, 0x6b, 0x65, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6f, 0x72, 0x79, 0x2f, 0x6b, 0x65, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x6c, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6f, 0x70, 0x6c, 0xaa, 0x02, 0x15, 0x4f, 0x72, 0x79, 0x2e,
+	0x4b, 0x65, 0x74, 0x6f, 0x2e, 0x4f, 0x70, 0x6c, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xca, 0x02, 0x15, 0x4f, 0x72, 0x79, 0x5c, 0x4b, 0x65, 0x74, 0x6f, 0x5c, 0x4f, 0x70, 0x6c,
+	0x5c, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescOnce sync.Once
+	file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescData = file_ory_keto_opl_v1alpha1_playground_service_proto_rawDesc
+)
+
+func file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescGZIP() []byte {
+	file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescOnce.Do(func() {
+		file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescData)
+	})
+	return file_ory_keto_opl_v1alpha1_playground_service_proto_rawDescData
+}
+
+var file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ory_keto_opl_v1alpha1_playground_service_proto_goTypes = []interface{}{
+	(*EvaluateRequest)(nil),        // 0: ory.keto.opl.v1alpha1.EvaluateRequest
+	(*EvaluateResponse)(nil),       // 1: ory.keto.opl.v1alpha1.EvaluateResponse
+	(*CheckResult)(nil),            // 2: ory.keto.opl.v1alpha1.CheckResult
+	(*ExpandResult)(nil),           // 3: ory.keto.opl.v1alpha1.ExpandResult
+	(*v1alpha2.RelationTuple)(nil), // 4: ory.keto.relation_tuples.v1alpha2.RelationTuple
+	(*v1alpha2.SubjectSet)(nil),    // 5: ory.keto.relation_tuples.v1alpha2.SubjectSet
+	(*ParseError)(nil),             // 6: ory.keto.opl.v1alpha1.ParseError
+	(*v1alpha2.SubjectTree)(nil),   // 7: ory.keto.relation_tuples.v1alpha2.SubjectTree
+}
+var file_ory_keto_opl_v1alpha1_playground_service_proto_depIdxs = []int32{
+	4,  // 0: ory.keto.opl.v1alpha1.EvaluateRequest.relation_tuples:type_name -> ory.keto.relation_tuples.v1alpha2.RelationTuple
+	4,  // 1: ory.keto.opl.v1alpha1.EvaluateRequest.checks:type_name -> ory.keto.relation_tuples.v1alpha2.RelationTuple
+	5,  // 2: ory.keto.opl.v1alpha1.EvaluateRequest.expands:type_name -> ory.keto.relation_tuples.v1alpha2.SubjectSet
+	6,  // 3: ory.keto.opl.v1alpha1.EvaluateResponse.parse_errors:type_name -> ory.keto.opl.v1alpha1.ParseError
+	2,  // 4: ory.keto.opl.v1alpha1.EvaluateResponse.checks:type_name -> ory.keto.opl.v1alpha1.CheckResult
+	3,  // 5: ory.keto.opl.v1alpha1.EvaluateResponse.expands:type_name -> ory.keto.opl.v1alpha1.ExpandResult
+	4,  // 6: ory.keto.opl.v1alpha1.CheckResult.relation_tuple:type_name -> ory.keto.relation_tuples.v1alpha2.RelationTuple
+	7,  // 7: ory.keto.opl.v1alpha1.CheckResult.tree:type_name -> ory.keto.relation_tuples.v1alpha2.SubjectTree
+	5,  // 8: ory.keto.opl.v1alpha1.ExpandResult.subject_set:type_name -> ory.keto.relation_tuples.v1alpha2.SubjectSet
+	7,  // 9: ory.keto.opl.v1alpha1.ExpandResult.tree:type_name -> ory.keto.relation_tuples.v1alpha2.SubjectTree
+	0,  // 10: ory.keto.opl.v1alpha1.PlaygroundService.Evaluate:input_type -> ory.keto.opl.v1alpha1.EvaluateRequest
+	1,  // 11: ory.keto.opl.v1alpha1.PlaygroundService.Evaluate:output_type -> ory.keto.opl.v1alpha1.EvaluateResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_ory_keto_opl_v1alpha1_playground_service_proto_init() }
+func file_ory_keto_opl_v1alpha1_playground_service_proto_init() {
+	if File_ory_keto_opl_v1alpha1_playground_service_proto != nil {
+		return
+	}
+	file_ory_keto_opl_v1alpha1_syntax_service_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpandResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ory_keto_opl_v1alpha1_playground_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ory_keto_opl_v1alpha1_playground_service_proto_goTypes,
+		DependencyIndexes: file_ory_keto_opl_v1alpha1_playground_service_proto_depIdxs,
+		MessageInfos:      file_ory_keto_opl_v1alpha1_playground_service_proto_msgTypes,
+	}.Build()
+	File_ory_keto_opl_v1alpha1_playground_service_proto = out.File
+	file_ory_keto_opl_v1alpha1_playground_service_proto_rawDesc = nil
+	file_ory_keto_opl_v1alpha1_playground_service_proto_goTypes = nil
+	file_ory_keto_opl_v1alpha1_playground_service_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: ory/keto/opl/v1alpha1/playground_service.proto
+
+package opl
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PlaygroundServiceClient is the client API for PlaygroundService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlaygroundServiceClient interface {
+	// Writes the relationships to a new, empty in-memory store that uses the
+	// OPL file as its namespaces, and evaluates the checks and expands against
+	// it. The store is discarded afterwards.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+}
+
+type playgroundServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaygroundServiceClient(cc grpc.ClientConnInterface) PlaygroundServiceClient {
+	return &playgroundServiceClient{cc}
+}
+
+func (c *playgroundServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/ory.keto.opl.v1alpha1.PlaygroundService/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaygroundServiceServer is the server API for PlaygroundService service.
+// All implementations should embed UnimplementedPlaygroundServiceServer
+// for forward compatibility
+type PlaygroundServiceServer interface {
+	// Writes the relationships to a new, empty in-memory store that uses the
+	// OPL file as its namespaces, and evaluates the checks and expands against
+	// it. The store is discarded afterwards.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+}
+
+// UnimplementedPlaygroundServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPlaygroundServiceServer struct {
+}
+
+func (UnimplementedPlaygroundServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+
+// UnsafePlaygroundServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaygroundServiceServer will
+// result in compilation errors.
+type UnsafePlaygroundServiceServer interface {
+	mustEmbedUnimplementedPlaygroundServiceServer()
+}
+
+func RegisterPlaygroundServiceServer(s grpc.ServiceRegistrar, srv PlaygroundServiceServer) {
+	s.RegisterService(&PlaygroundService_ServiceDesc, srv)
+}
+
+func _PlaygroundService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ory.keto.opl.v1alpha1.PlaygroundService/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaygroundService_ServiceDesc is the grpc.ServiceDesc for PlaygroundService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ory.keto.opl.v1alpha1.PlaygroundService",
+	HandlerType: (*PlaygroundServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _PlaygroundService_Evaluate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ory/keto/opl/v1alpha1/playground_service.proto",
+}
@@ -0,0 +1,44 @@
+// package: ory.keto.opl.v1alpha1
+// file: ory/keto/opl/v1alpha1/playground_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "grpc";
+import * as ory_keto_opl_v1alpha1_playground_service_pb from "../../../../ory/keto/opl/v1alpha1/playground_service_pb";
+import * as ory_keto_opl_v1alpha1_syntax_service_pb from "../../../../ory/keto/opl/v1alpha1/syntax_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_expand_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/expand_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+
+interface IPlaygroundServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    evaluate: IPlaygroundServiceService_IEvaluate;
+}
+
+interface IPlaygroundServiceService_IEvaluate extends grpc.MethodDefinition<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse> {
+    path: "/ory.keto.opl.v1alpha1.PlaygroundService/Evaluate";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest>;
+    responseSerialize: grpc.serialize<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse>;
+}
+
+export const PlaygroundServiceService: IPlaygroundServiceService;
+
+export interface IPlaygroundServiceServer {
+    evaluate: grpc.handleUnaryCall<ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse>;
+}
+
+export interface IPlaygroundServiceClient {
+    evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+    evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+    evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class PlaygroundServiceClient extends grpc.Client implements IPlaygroundServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+    public evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+    public evaluate(request: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse) => void): grpc.ClientUnaryCall;
+}
@@ -0,0 +1,52 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var ory_keto_opl_v1alpha1_playground_service_pb = require('../../../../ory/keto/opl/v1alpha1/playground_service_pb.js');
+var ory_keto_opl_v1alpha1_syntax_service_pb = require('../../../../ory/keto/opl/v1alpha1/syntax_service_pb.js');
+var ory_keto_relation_tuples_v1alpha2_expand_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/expand_service_pb.js');
+var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
+
+function serialize_ory_keto_opl_v1alpha1_EvaluateRequest(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.EvaluateRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_EvaluateRequest(buffer_arg) {
+  return ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_EvaluateResponse(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.EvaluateResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_EvaluateResponse(buffer_arg) {
+  return ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// The service that evaluates an OPL file against relationships, without
+// touching the database.
+var PlaygroundServiceService = exports.PlaygroundServiceService = {
+  // Writes the relationships to a new, empty in-memory store that uses the
+  // OPL file as its namespaces, and evaluates the checks and expands against
+  // it. The store is discarded afterwards.
+evaluate: {
+    path: '/ory.keto.opl.v1alpha1.PlaygroundService/Evaluate',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateRequest,
+    responseType: ory_keto_opl_v1alpha1_playground_service_pb.EvaluateResponse,
+    requestSerialize: serialize_ory_keto_opl_v1alpha1_EvaluateRequest,
+    requestDeserialize: deserialize_ory_keto_opl_v1alpha1_EvaluateRequest,
+    responseSerialize: serialize_ory_keto_opl_v1alpha1_EvaluateResponse,
+    responseDeserialize: deserialize_ory_keto_opl_v1alpha1_EvaluateResponse,
+  },
+};
+
+exports.PlaygroundServiceClient = grpc.makeGenericClientConstructor(PlaygroundServiceService);
@@ -0,0 +1,149 @@
+// package: ory.keto.opl.v1alpha1
+// file: ory/keto/opl/v1alpha1/playground_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as ory_keto_opl_v1alpha1_syntax_service_pb from "../../../../ory/keto/opl/v1alpha1/syntax_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_expand_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/expand_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+
+export class EvaluateRequest extends jspb.Message { 
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): EvaluateRequest;
+    clearRelationTuplesList(): void;
+    getRelationTuplesList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>;
+    setRelationTuplesList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>): EvaluateRequest;
+    addRelationTuples(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+    clearChecksList(): void;
+    getChecksList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>;
+    setChecksList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>): EvaluateRequest;
+    addChecks(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+    clearExpandsList(): void;
+    getExpandsList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet>;
+    setExpandsList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet>): EvaluateRequest;
+    addExpands(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet;
+    getMaxDepth(): number;
+    setMaxDepth(value: number): EvaluateRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): EvaluateRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: EvaluateRequest): EvaluateRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: EvaluateRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): EvaluateRequest;
+    static deserializeBinaryFromReader(message: EvaluateRequest, reader: jspb.BinaryReader): EvaluateRequest;
+}
+
+export namespace EvaluateRequest {
+    export type AsObject = {
+        content: Uint8Array | string,
+        relationTuplesList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject>,
+        checksList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject>,
+        expandsList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.AsObject>,
+        maxDepth: number,
+    }
+}
+
+export class EvaluateResponse extends jspb.Message { 
+    clearParseErrorsList(): void;
+    getParseErrorsList(): Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError>;
+    setParseErrorsList(value: Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError>): EvaluateResponse;
+    addParseErrors(value?: ory_keto_opl_v1alpha1_syntax_service_pb.ParseError, index?: number): ory_keto_opl_v1alpha1_syntax_service_pb.ParseError;
+    clearChecksList(): void;
+    getChecksList(): Array<CheckResult>;
+    setChecksList(value: Array<CheckResult>): EvaluateResponse;
+    addChecks(value?: CheckResult, index?: number): CheckResult;
+    clearExpandsList(): void;
+    getExpandsList(): Array<ExpandResult>;
+    setExpandsList(value: Array<ExpandResult>): EvaluateResponse;
+    addExpands(value?: ExpandResult, index?: number): ExpandResult;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): EvaluateResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: EvaluateResponse): EvaluateResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: EvaluateResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): EvaluateResponse;
+    static deserializeBinaryFromReader(message: EvaluateResponse, reader: jspb.BinaryReader): EvaluateResponse;
+}
+
+export namespace EvaluateResponse {
+    export type AsObject = {
+        parseErrorsList: Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.AsObject>,
+        checksList: Array<CheckResult.AsObject>,
+        expandsList: Array<ExpandResult.AsObject>,
+    }
+}
+
+export class CheckResult extends jspb.Message { 
+
+    hasRelationTuple(): boolean;
+    clearRelationTuple(): void;
+    getRelationTuple(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple | undefined;
+    setRelationTuple(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple): CheckResult;
+    getAllowed(): boolean;
+    setAllowed(value: boolean): CheckResult;
+
+    hasTree(): boolean;
+    clearTree(): void;
+    getTree(): ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree | undefined;
+    setTree(value?: ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree): CheckResult;
+    getError(): string;
+    setError(value: string): CheckResult;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): CheckResult.AsObject;
+    static toObject(includeInstance: boolean, msg: CheckResult): CheckResult.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: CheckResult, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): CheckResult;
+    static deserializeBinaryFromReader(message: CheckResult, reader: jspb.BinaryReader): CheckResult;
+}
+
+export namespace CheckResult {
+    export type AsObject = {
+        relationTuple?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject,
+        allowed: boolean,
+        tree?: ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.AsObject,
+        error: string,
+    }
+}
+
+export class ExpandResult extends jspb.Message { 
+
+    hasSubjectSet(): boolean;
+    clearSubjectSet(): void;
+    getSubjectSet(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet | undefined;
+    setSubjectSet(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet): ExpandResult;
+
+    hasTree(): boolean;
+    clearTree(): void;
+    getTree(): ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree | undefined;
+    setTree(value?: ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree): ExpandResult;
+    getError(): string;
+    setError(value: string): ExpandResult;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExpandResult.AsObject;
+    static toObject(includeInstance: boolean, msg: ExpandResult): ExpandResult.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExpandResult, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExpandResult;
+    static deserializeBinaryFromReader(message: ExpandResult, reader: jspb.BinaryReader): ExpandResult;
+}
+
+export namespace ExpandResult {
+    export type AsObject = {
+        subjectSet?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.AsObject,
+        tree?: ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.AsObject,
+        error: string,
+    }
+}
@@ -0,0 +1,1228 @@
+// source: ory/keto/opl/v1alpha1/playground_service.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global =
+    (typeof globalThis !== 'undefined' && globalThis) ||
+    (typeof window !== 'undefined' && window) ||
+    (typeof global !== 'undefined' && global) ||
+    (typeof self !== 'undefined' && self) ||
+    (function () { return this; }).call(null) ||
+    Function('return this')();
+
+var ory_keto_opl_v1alpha1_syntax_service_pb = require('../../../../ory/keto/opl/v1alpha1/syntax_service_pb.js');
+goog.object.extend(proto, ory_keto_opl_v1alpha1_syntax_service_pb);
+var ory_keto_relation_tuples_v1alpha2_expand_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/expand_service_pb.js');
+goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_expand_service_pb);
+var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
+goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.CheckResult', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.EvaluateRequest', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.EvaluateResponse', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.ExpandResult', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.opl.v1alpha1.EvaluateRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.EvaluateRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.EvaluateRequest.displayName = 'proto.ory.keto.opl.v1alpha1.EvaluateRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.opl.v1alpha1.EvaluateResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.EvaluateResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.EvaluateResponse.displayName = 'proto.ory.keto.opl.v1alpha1.EvaluateResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.CheckResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.CheckResult.displayName = 'proto.ory.keto.opl.v1alpha1.CheckResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.ExpandResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.ExpandResult.displayName = 'proto.ory.keto.opl.v1alpha1.ExpandResult';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.repeatedFields_ = [2,3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.EvaluateRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    content: msg.getContent_asB64(),
+    relationTuplesList: jspb.Message.toObjectList(msg.getRelationTuplesList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject, includeInstance),
+    checksList: jspb.Message.toObjectList(msg.getChecksList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject, includeInstance),
+    expandsList: jspb.Message.toObjectList(msg.getExpandsList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.toObject, includeInstance),
+    maxDepth: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.EvaluateRequest;
+  return proto.ory.keto.opl.v1alpha1.EvaluateRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
+      break;
+    case 2:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.addRelationTuples(value);
+      break;
+    case 3:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.addChecks(value);
+      break;
+    case 4:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.deserializeBinaryFromReader);
+      msg.addExpands(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxDepth(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.EvaluateRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getRelationTuplesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getChecksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpandsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxDepth();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes content = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * repeated ory.keto.relation_tuples.v1alpha2.RelationTuple relation_tuples = 2;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getRelationTuplesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.setRelationTuplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.addRelationTuples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTuple, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.clearRelationTuplesList = function() {
+  return this.setRelationTuplesList([]);
+};
+
+
+/**
+ * repeated ory.keto.relation_tuples.v1alpha2.RelationTuple checks = 3;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getChecksList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.setChecksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.addChecks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTuple, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.clearChecksList = function() {
+  return this.setChecksList([]);
+};
+
+
+/**
+ * repeated ory.keto.relation_tuples.v1alpha2.SubjectSet expands = 4;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.SubjectSet>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getExpandsList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.SubjectSet>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.SubjectSet>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.setExpandsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SubjectSet=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SubjectSet}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.addExpands = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ory.keto.relation_tuples.v1alpha2.SubjectSet, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.clearExpandsList = function() {
+  return this.setExpandsList([]);
+};
+
+
+/**
+ * optional int32 max_depth = 5;
+ * @return {number}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.getMaxDepth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateRequest.prototype.setMaxDepth = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.repeatedFields_ = [1,2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.EvaluateResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    parseErrorsList: jspb.Message.toObjectList(msg.getParseErrorsList(),
+    ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.toObject, includeInstance),
+    checksList: jspb.Message.toObjectList(msg.getChecksList(),
+    proto.ory.keto.opl.v1alpha1.CheckResult.toObject, includeInstance),
+    expandsList: jspb.Message.toObjectList(msg.getExpandsList(),
+    proto.ory.keto.opl.v1alpha1.ExpandResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.EvaluateResponse;
+  return proto.ory.keto.opl.v1alpha1.EvaluateResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_opl_v1alpha1_syntax_service_pb.ParseError;
+      reader.readMessage(value,ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.deserializeBinaryFromReader);
+      msg.addParseErrors(value);
+      break;
+    case 2:
+      var value = new proto.ory.keto.opl.v1alpha1.CheckResult;
+      reader.readMessage(value,proto.ory.keto.opl.v1alpha1.CheckResult.deserializeBinaryFromReader);
+      msg.addChecks(value);
+      break;
+    case 3:
+      var value = new proto.ory.keto.opl.v1alpha1.ExpandResult;
+      reader.readMessage(value,proto.ory.keto.opl.v1alpha1.ExpandResult.deserializeBinaryFromReader);
+      msg.addExpands(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.EvaluateResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getParseErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.serializeBinaryToWriter
+    );
+  }
+  f = message.getChecksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ory.keto.opl.v1alpha1.CheckResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpandsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.ory.keto.opl.v1alpha1.ExpandResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ParseError parse_errors = 1;
+ * @return {!Array<!proto.ory.keto.opl.v1alpha1.ParseError>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.getParseErrorsList = function() {
+  return /** @type{!Array<!proto.ory.keto.opl.v1alpha1.ParseError>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_opl_v1alpha1_syntax_service_pb.ParseError, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.opl.v1alpha1.ParseError>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.setParseErrorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.opl.v1alpha1.ParseError=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.opl.v1alpha1.ParseError}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.addParseErrors = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.opl.v1alpha1.ParseError, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.clearParseErrorsList = function() {
+  return this.setParseErrorsList([]);
+};
+
+
+/**
+ * repeated CheckResult checks = 2;
+ * @return {!Array<!proto.ory.keto.opl.v1alpha1.CheckResult>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.getChecksList = function() {
+  return /** @type{!Array<!proto.ory.keto.opl.v1alpha1.CheckResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.opl.v1alpha1.CheckResult, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.opl.v1alpha1.CheckResult>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.setChecksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.opl.v1alpha1.CheckResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.addChecks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.opl.v1alpha1.CheckResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.clearChecksList = function() {
+  return this.setChecksList([]);
+};
+
+
+/**
+ * repeated ExpandResult expands = 3;
+ * @return {!Array<!proto.ory.keto.opl.v1alpha1.ExpandResult>}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.getExpandsList = function() {
+  return /** @type{!Array<!proto.ory.keto.opl.v1alpha1.ExpandResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.opl.v1alpha1.ExpandResult, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.opl.v1alpha1.ExpandResult>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.setExpandsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.opl.v1alpha1.ExpandResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult}
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.addExpands = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.ory.keto.opl.v1alpha1.ExpandResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.EvaluateResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.EvaluateResponse.prototype.clearExpandsList = function() {
+  return this.setExpandsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.CheckResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.CheckResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    relationTuple: (f = msg.getRelationTuple()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f),
+    allowed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    tree: (f = msg.getTree()) && ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.toObject(includeInstance, f),
+    error: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.CheckResult;
+  return proto.ory.keto.opl.v1alpha1.CheckResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.CheckResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.setRelationTuple(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowed(value);
+      break;
+    case 3:
+      var value = new ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.deserializeBinaryFromReader);
+      msg.setTree(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.CheckResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.CheckResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRelationTuple();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getAllowed();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getTree();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional ory.keto.relation_tuples.v1alpha2.RelationTuple relation_tuple = 1;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.getRelationTuple = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+*/
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.setRelationTuple = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.clearRelationTuple = function() {
+  return this.setRelationTuple(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.hasRelationTuple = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bool allowed = 2;
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.getAllowed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.setAllowed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional ory.keto.relation_tuples.v1alpha2.SubjectTree tree = 3;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.getTree = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree, 3));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+*/
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.setTree = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.clearTree = function() {
+  return this.setTree(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.hasTree = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string error = 4;
+ * @return {string}
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.opl.v1alpha1.CheckResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.CheckResult.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.ExpandResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.ExpandResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    subjectSet: (f = msg.getSubjectSet()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.toObject(includeInstance, f),
+    tree: (f = msg.getTree()) && ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.toObject(includeInstance, f),
+    error: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.ExpandResult;
+  return proto.ory.keto.opl.v1alpha1.ExpandResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.ExpandResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.deserializeBinaryFromReader);
+      msg.setSubjectSet(value);
+      break;
+    case 2:
+      var value = new ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.deserializeBinaryFromReader);
+      msg.setTree(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.ExpandResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.ExpandResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubjectSet();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet.serializeBinaryToWriter
+    );
+  }
+  f = message.getTree();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional ory.keto.relation_tuples.v1alpha2.SubjectSet subject_set = 1;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.SubjectSet}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.getSubjectSet = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.SubjectSet} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.SubjectSet, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.SubjectSet|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult} returns this
+*/
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.setSubjectSet = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.clearSubjectSet = function() {
+  return this.setSubjectSet(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.hasSubjectSet = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ory.keto.relation_tuples.v1alpha2.SubjectTree tree = 2;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.getTree = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_expand_service_pb.SubjectTree, 2));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.SubjectTree|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult} returns this
+*/
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.setTree = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.clearTree = function() {
+  return this.setTree(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.hasTree = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string error = 3;
+ * @return {string}
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.opl.v1alpha1.ExpandResult} returns this
+ */
+proto.ory.keto.opl.v1alpha1.ExpandResult.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+goog.object.extend(exports, proto.ory.keto.opl.v1alpha1);
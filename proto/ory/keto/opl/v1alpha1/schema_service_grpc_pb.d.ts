@@ -0,0 +1,77 @@
+// package: ory.keto.opl.v1alpha1
+// file: ory/keto/opl/v1alpha1/schema_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "grpc";
+import * as ory_keto_opl_v1alpha1_schema_service_pb from "../../../../ory/keto/opl/v1alpha1/schema_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+import * as ory_keto_opl_v1alpha1_syntax_service_pb from "../../../../ory/keto/opl/v1alpha1/syntax_service_pb";
+
+interface ISchemaServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    writeSchema: ISchemaServiceService_IWriteSchema;
+    listSchemaVersions: ISchemaServiceService_IListSchemaVersions;
+    rollbackSchema: ISchemaServiceService_IRollbackSchema;
+}
+
+interface ISchemaServiceService_IWriteSchema extends grpc.MethodDefinition<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse> {
+    path: "/ory.keto.opl.v1alpha1.SchemaService/WriteSchema";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest>;
+    responseSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse>;
+}
+interface ISchemaServiceService_IListSchemaVersions extends grpc.MethodDefinition<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse> {
+    path: "/ory.keto.opl.v1alpha1.SchemaService/ListSchemaVersions";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest>;
+    responseSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse>;
+}
+interface ISchemaServiceService_IRollbackSchema extends grpc.MethodDefinition<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse> {
+    path: "/ory.keto.opl.v1alpha1.SchemaService/RollbackSchema";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest>;
+    responseSerialize: grpc.serialize<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse>;
+}
+
+export const SchemaServiceService: ISchemaServiceService;
+
+export interface ISchemaServiceServer {
+    writeSchema: grpc.handleUnaryCall<ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse>;
+    listSchemaVersions: grpc.handleUnaryCall<ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse>;
+    rollbackSchema: grpc.handleUnaryCall<ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse>;
+}
+
+export interface ISchemaServiceClient {
+    writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+    rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+    rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class SchemaServiceClient extends grpc.Client implements ISchemaServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    public writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    public writeSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse) => void): grpc.ClientUnaryCall;
+    public listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    public listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    public listSchemaVersions(request: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse) => void): grpc.ClientUnaryCall;
+    public rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+    public rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+    public rollbackSchema(request: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse) => void): grpc.ClientUnaryCall;
+}
@@ -0,0 +1,121 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var ory_keto_opl_v1alpha1_schema_service_pb = require('../../../../ory/keto/opl/v1alpha1/schema_service_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+var ory_keto_opl_v1alpha1_syntax_service_pb = require('../../../../ory/keto/opl/v1alpha1/syntax_service_pb.js');
+
+function serialize_ory_keto_opl_v1alpha1_ListSchemaVersionsRequest(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.ListSchemaVersionsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_ListSchemaVersionsRequest(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_ListSchemaVersionsResponse(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.ListSchemaVersionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_ListSchemaVersionsResponse(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_RollbackSchemaRequest(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.RollbackSchemaRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_RollbackSchemaRequest(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_RollbackSchemaResponse(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.RollbackSchemaResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_RollbackSchemaResponse(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_WriteSchemaRequest(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.WriteSchemaRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_WriteSchemaRequest(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_opl_v1alpha1_WriteSchemaResponse(arg) {
+  if (!(arg instanceof ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse)) {
+    throw new Error('Expected argument of type ory.keto.opl.v1alpha1.WriteSchemaResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_opl_v1alpha1_WriteSchemaResponse(buffer_arg) {
+  return ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// The service that manages the OPL schema versions stored in the database.
+//
+// This service is part of the [write-APIs](../concepts/api-overview.mdx#write-apis).
+// The stored schema is only used if the namespaces are configured to be
+// loaded from the database.
+var SchemaServiceService = exports.SchemaServiceService = {
+  // Stores a new version of the OPL schema and makes it the active one.
+writeSchema: {
+    path: '/ory.keto.opl.v1alpha1.SchemaService/WriteSchema',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaRequest,
+    responseType: ory_keto_opl_v1alpha1_schema_service_pb.WriteSchemaResponse,
+    requestSerialize: serialize_ory_keto_opl_v1alpha1_WriteSchemaRequest,
+    requestDeserialize: deserialize_ory_keto_opl_v1alpha1_WriteSchemaRequest,
+    responseSerialize: serialize_ory_keto_opl_v1alpha1_WriteSchemaResponse,
+    responseDeserialize: deserialize_ory_keto_opl_v1alpha1_WriteSchemaResponse,
+  },
+  // Lists all stored versions of the OPL schema.
+listSchemaVersions: {
+    path: '/ory.keto.opl.v1alpha1.SchemaService/ListSchemaVersions',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsRequest,
+    responseType: ory_keto_opl_v1alpha1_schema_service_pb.ListSchemaVersionsResponse,
+    requestSerialize: serialize_ory_keto_opl_v1alpha1_ListSchemaVersionsRequest,
+    requestDeserialize: deserialize_ory_keto_opl_v1alpha1_ListSchemaVersionsRequest,
+    responseSerialize: serialize_ory_keto_opl_v1alpha1_ListSchemaVersionsResponse,
+    responseDeserialize: deserialize_ory_keto_opl_v1alpha1_ListSchemaVersionsResponse,
+  },
+  // Makes an earlier version of the OPL schema the active one again, by
+  // storing its content as a new version.
+rollbackSchema: {
+    path: '/ory.keto.opl.v1alpha1.SchemaService/RollbackSchema',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaRequest,
+    responseType: ory_keto_opl_v1alpha1_schema_service_pb.RollbackSchemaResponse,
+    requestSerialize: serialize_ory_keto_opl_v1alpha1_RollbackSchemaRequest,
+    requestDeserialize: deserialize_ory_keto_opl_v1alpha1_RollbackSchemaRequest,
+    responseSerialize: serialize_ory_keto_opl_v1alpha1_RollbackSchemaResponse,
+    responseDeserialize: deserialize_ory_keto_opl_v1alpha1_RollbackSchemaResponse,
+  },
+};
+
+exports.SchemaServiceClient = grpc.makeGenericClientConstructor(SchemaServiceService);
@@ -0,0 +1,172 @@
+// package: ory.keto.opl.v1alpha1
+// file: ory/keto/opl/v1alpha1/schema_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+import * as ory_keto_opl_v1alpha1_syntax_service_pb from "../../../../ory/keto/opl/v1alpha1/syntax_service_pb";
+
+export class WriteSchemaRequest extends jspb.Message { 
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): WriteSchemaRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WriteSchemaRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: WriteSchemaRequest): WriteSchemaRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WriteSchemaRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WriteSchemaRequest;
+    static deserializeBinaryFromReader(message: WriteSchemaRequest, reader: jspb.BinaryReader): WriteSchemaRequest;
+}
+
+export namespace WriteSchemaRequest {
+    export type AsObject = {
+        content: Uint8Array | string,
+    }
+}
+
+export class WriteSchemaResponse extends jspb.Message { 
+
+    hasVersion(): boolean;
+    clearVersion(): void;
+    getVersion(): SchemaVersion | undefined;
+    setVersion(value?: SchemaVersion): WriteSchemaResponse;
+    clearParseErrorsList(): void;
+    getParseErrorsList(): Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError>;
+    setParseErrorsList(value: Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError>): WriteSchemaResponse;
+    addParseErrors(value?: ory_keto_opl_v1alpha1_syntax_service_pb.ParseError, index?: number): ory_keto_opl_v1alpha1_syntax_service_pb.ParseError;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WriteSchemaResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: WriteSchemaResponse): WriteSchemaResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WriteSchemaResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WriteSchemaResponse;
+    static deserializeBinaryFromReader(message: WriteSchemaResponse, reader: jspb.BinaryReader): WriteSchemaResponse;
+}
+
+export namespace WriteSchemaResponse {
+    export type AsObject = {
+        version?: SchemaVersion.AsObject,
+        parseErrorsList: Array<ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.AsObject>,
+    }
+}
+
+export class ListSchemaVersionsRequest extends jspb.Message { 
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListSchemaVersionsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListSchemaVersionsRequest): ListSchemaVersionsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListSchemaVersionsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListSchemaVersionsRequest;
+    static deserializeBinaryFromReader(message: ListSchemaVersionsRequest, reader: jspb.BinaryReader): ListSchemaVersionsRequest;
+}
+
+export namespace ListSchemaVersionsRequest {
+    export type AsObject = {
+    }
+}
+
+export class ListSchemaVersionsResponse extends jspb.Message { 
+    clearVersionsList(): void;
+    getVersionsList(): Array<SchemaVersion>;
+    setVersionsList(value: Array<SchemaVersion>): ListSchemaVersionsResponse;
+    addVersions(value?: SchemaVersion, index?: number): SchemaVersion;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListSchemaVersionsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListSchemaVersionsResponse): ListSchemaVersionsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListSchemaVersionsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListSchemaVersionsResponse;
+    static deserializeBinaryFromReader(message: ListSchemaVersionsResponse, reader: jspb.BinaryReader): ListSchemaVersionsResponse;
+}
+
+export namespace ListSchemaVersionsResponse {
+    export type AsObject = {
+        versionsList: Array<SchemaVersion.AsObject>,
+    }
+}
+
+export class RollbackSchemaRequest extends jspb.Message { 
+    getVersion(): number;
+    setVersion(value: number): RollbackSchemaRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RollbackSchemaRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RollbackSchemaRequest): RollbackSchemaRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RollbackSchemaRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RollbackSchemaRequest;
+    static deserializeBinaryFromReader(message: RollbackSchemaRequest, reader: jspb.BinaryReader): RollbackSchemaRequest;
+}
+
+export namespace RollbackSchemaRequest {
+    export type AsObject = {
+        version: number,
+    }
+}
+
+export class RollbackSchemaResponse extends jspb.Message { 
+
+    hasVersion(): boolean;
+    clearVersion(): void;
+    getVersion(): SchemaVersion | undefined;
+    setVersion(value?: SchemaVersion): RollbackSchemaResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RollbackSchemaResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RollbackSchemaResponse): RollbackSchemaResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RollbackSchemaResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RollbackSchemaResponse;
+    static deserializeBinaryFromReader(message: RollbackSchemaResponse, reader: jspb.BinaryReader): RollbackSchemaResponse;
+}
+
+export namespace RollbackSchemaResponse {
+    export type AsObject = {
+        version?: SchemaVersion.AsObject,
+    }
+}
+
+export class SchemaVersion extends jspb.Message { 
+    getVersion(): number;
+    setVersion(value: number): SchemaVersion;
+    getContent(): Uint8Array | string;
+    getContent_asU8(): Uint8Array;
+    getContent_asB64(): string;
+    setContent(value: Uint8Array | string): SchemaVersion;
+
+    hasCreatedAt(): boolean;
+    clearCreatedAt(): void;
+    getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): SchemaVersion;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SchemaVersion.AsObject;
+    static toObject(includeInstance: boolean, msg: SchemaVersion): SchemaVersion.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SchemaVersion, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SchemaVersion;
+    static deserializeBinaryFromReader(message: SchemaVersion, reader: jspb.BinaryReader): SchemaVersion;
+}
+
+export namespace SchemaVersion {
+    export type AsObject = {
+        version: number,
+        content: Uint8Array | string,
+        createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
@@ -0,0 +1,1324 @@
+// source: ory/keto/opl/v1alpha1/schema_service.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global =
+    (typeof globalThis !== 'undefined' && globalThis) ||
+    (typeof window !== 'undefined' && window) ||
+    (typeof global !== 'undefined' && global) ||
+    (typeof self !== 'undefined' && self) ||
+    (function () { return this; }).call(null) ||
+    Function('return this')();
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+var ory_keto_opl_v1alpha1_syntax_service_pb = require('../../../../ory/keto/opl/v1alpha1/syntax_service_pb.js');
+goog.object.extend(proto, ory_keto_opl_v1alpha1_syntax_service_pb);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.SchemaVersion', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.WriteSchemaRequest', null, global);
+goog.exportSymbol('proto.ory.keto.opl.v1alpha1.WriteSchemaResponse', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.WriteSchemaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.displayName = 'proto.ory.keto.opl.v1alpha1.WriteSchemaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.WriteSchemaResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.displayName = 'proto.ory.keto.opl.v1alpha1.WriteSchemaResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.displayName = 'proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.displayName = 'proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.displayName = 'proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.displayName = 'proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.opl.v1alpha1.SchemaVersion, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.opl.v1alpha1.SchemaVersion.displayName = 'proto.ory.keto.opl.v1alpha1.SchemaVersion';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    content: msg.getContent_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.WriteSchemaRequest;
+  return proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes content = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaRequest.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: (f = msg.getVersion()) && proto.ory.keto.opl.v1alpha1.SchemaVersion.toObject(includeInstance, f),
+    parseErrorsList: jspb.Message.toObjectList(msg.getParseErrorsList(),
+    ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.WriteSchemaResponse;
+  return proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.opl.v1alpha1.SchemaVersion;
+      reader.readMessage(value,proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinaryFromReader);
+      msg.setVersion(value);
+      break;
+    case 2:
+      var value = new ory_keto_opl_v1alpha1_syntax_service_pb.ParseError;
+      reader.readMessage(value,ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.deserializeBinaryFromReader);
+      msg.addParseErrors(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ory.keto.opl.v1alpha1.SchemaVersion.serializeBinaryToWriter
+    );
+  }
+  f = message.getParseErrorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      ory_keto_opl_v1alpha1_syntax_service_pb.ParseError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional SchemaVersion version = 1;
+ * @return {?proto.ory.keto.opl.v1alpha1.SchemaVersion}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.getVersion = function() {
+  return /** @type{?proto.ory.keto.opl.v1alpha1.SchemaVersion} */ (
+    jspb.Message.getWrapperField(this, proto.ory.keto.opl.v1alpha1.SchemaVersion, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.opl.v1alpha1.SchemaVersion|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.setVersion = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.clearVersion = function() {
+  return this.setVersion(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.hasVersion = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated ParseError parse_errors = 2;
+ * @return {!Array<!proto.ory.keto.opl.v1alpha1.ParseError>}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.getParseErrorsList = function() {
+  return /** @type{!Array<!proto.ory.keto.opl.v1alpha1.ParseError>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_opl_v1alpha1_syntax_service_pb.ParseError, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.opl.v1alpha1.ParseError>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.setParseErrorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.opl.v1alpha1.ParseError=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.opl.v1alpha1.ParseError}
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.addParseErrors = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.opl.v1alpha1.ParseError, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.WriteSchemaResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.WriteSchemaResponse.prototype.clearParseErrorsList = function() {
+  return this.setParseErrorsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest;
+  return proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    versionsList: jspb.Message.toObjectList(msg.getVersionsList(),
+    proto.ory.keto.opl.v1alpha1.SchemaVersion.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse;
+  return proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.opl.v1alpha1.SchemaVersion;
+      reader.readMessage(value,proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinaryFromReader);
+      msg.addVersions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ory.keto.opl.v1alpha1.SchemaVersion.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated SchemaVersion versions = 1;
+ * @return {!Array<!proto.ory.keto.opl.v1alpha1.SchemaVersion>}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.getVersionsList = function() {
+  return /** @type{!Array<!proto.ory.keto.opl.v1alpha1.SchemaVersion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.opl.v1alpha1.SchemaVersion, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.opl.v1alpha1.SchemaVersion>} value
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.setVersionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.opl.v1alpha1.SchemaVersion=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion}
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.addVersions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.opl.v1alpha1.SchemaVersion, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.ListSchemaVersionsResponse.prototype.clearVersionsList = function() {
+  return this.setVersionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest;
+  return proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 version = 1;
+ * @return {number}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest} returns this
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: (f = msg.getVersion()) && proto.ory.keto.opl.v1alpha1.SchemaVersion.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse;
+  return proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.opl.v1alpha1.SchemaVersion;
+      reader.readMessage(value,proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinaryFromReader);
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.ory.keto.opl.v1alpha1.SchemaVersion.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional SchemaVersion version = 1;
+ * @return {?proto.ory.keto.opl.v1alpha1.SchemaVersion}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.getVersion = function() {
+  return /** @type{?proto.ory.keto.opl.v1alpha1.SchemaVersion} */ (
+    jspb.Message.getWrapperField(this, proto.ory.keto.opl.v1alpha1.SchemaVersion, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.opl.v1alpha1.SchemaVersion|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse} returns this
+*/
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.setVersion = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse} returns this
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.clearVersion = function() {
+  return this.setVersion(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.RollbackSchemaResponse.prototype.hasVersion = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.opl.v1alpha1.SchemaVersion.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.opl.v1alpha1.SchemaVersion} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    content: msg.getContent_asB64(),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.opl.v1alpha1.SchemaVersion;
+  return proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.opl.v1alpha1.SchemaVersion} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.opl.v1alpha1.SchemaVersion.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.opl.v1alpha1.SchemaVersion} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getContent_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 version = 1;
+ * @return {number}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion} returns this
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes content = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes content = 2;
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {string}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
+};
+
+
+/**
+ * optional bytes content = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion} returns this
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion} returns this
+*/
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.opl.v1alpha1.SchemaVersion} returns this
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.opl.v1alpha1.SchemaVersion.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+goog.object.extend(exports, proto.ory.keto.opl.v1alpha1);
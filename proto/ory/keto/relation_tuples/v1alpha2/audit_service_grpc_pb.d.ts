@@ -0,0 +1,43 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/audit_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "grpc";
+import * as ory_keto_relation_tuples_v1alpha2_audit_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/audit_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+interface IAuditServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    listAuditEntries: IAuditServiceService_IListAuditEntries;
+}
+
+interface IAuditServiceService_IListAuditEntries extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.AuditService/ListAuditEntries";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse>;
+}
+
+export const AuditServiceService: IAuditServiceService;
+
+export interface IAuditServiceServer {
+    listAuditEntries: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse>;
+}
+
+export interface IAuditServiceClient {
+    listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+    listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+    listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class AuditServiceClient extends grpc.Client implements IAuditServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+    public listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+    public listAuditEntries(request: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse) => void): grpc.ClientUnaryCall;
+}
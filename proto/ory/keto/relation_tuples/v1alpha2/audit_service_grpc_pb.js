@@ -0,0 +1,50 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var ory_keto_relation_tuples_v1alpha2_audit_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/audit_service_pb.js');
+var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// The service to query the audit log of relationship writes.
+//
+// This service is part of the [write-APIs](../concepts/api-overview.mdx#write-apis).
+var AuditServiceService = exports.AuditServiceService = {
+  // Lists the audit log entries, the latest first.
+listAuditEntries: {
+    path: '/ory.keto.relation_tuples.v1alpha2.AuditService/ListAuditEntries',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_audit_service_pb.ListAuditEntriesResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ListAuditEntriesResponse,
+  },
+};
+
+exports.AuditServiceClient = grpc.makeGenericClientConstructor(AuditServiceService);
@@ -0,0 +1,103 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/audit_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+export class ListAuditEntriesRequest extends jspb.Message { 
+    getActor(): string;
+    setActor(value: string): ListAuditEntriesRequest;
+    getRequestId(): string;
+    setRequestId(value: string): ListAuditEntriesRequest;
+    getPageSize(): number;
+    setPageSize(value: number): ListAuditEntriesRequest;
+    getPageToken(): string;
+    setPageToken(value: string): ListAuditEntriesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListAuditEntriesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListAuditEntriesRequest): ListAuditEntriesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListAuditEntriesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListAuditEntriesRequest;
+    static deserializeBinaryFromReader(message: ListAuditEntriesRequest, reader: jspb.BinaryReader): ListAuditEntriesRequest;
+}
+
+export namespace ListAuditEntriesRequest {
+    export type AsObject = {
+        actor: string,
+        requestId: string,
+        pageSize: number,
+        pageToken: string,
+    }
+}
+
+export class ListAuditEntriesResponse extends jspb.Message { 
+    clearEntriesList(): void;
+    getEntriesList(): Array<AuditEntry>;
+    setEntriesList(value: Array<AuditEntry>): ListAuditEntriesResponse;
+    addEntries(value?: AuditEntry, index?: number): AuditEntry;
+    getNextPageToken(): string;
+    setNextPageToken(value: string): ListAuditEntriesResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListAuditEntriesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListAuditEntriesResponse): ListAuditEntriesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListAuditEntriesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListAuditEntriesResponse;
+    static deserializeBinaryFromReader(message: ListAuditEntriesResponse, reader: jspb.BinaryReader): ListAuditEntriesResponse;
+}
+
+export namespace ListAuditEntriesResponse {
+    export type AsObject = {
+        entriesList: Array<AuditEntry.AsObject>,
+        nextPageToken: string,
+    }
+}
+
+export class AuditEntry extends jspb.Message { 
+
+    hasTime(): boolean;
+    clearTime(): void;
+    getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setTime(value?: google_protobuf_timestamp_pb.Timestamp): AuditEntry;
+    getOperation(): string;
+    setOperation(value: string): AuditEntry;
+    getActor(): string;
+    setActor(value: string): AuditEntry;
+    getActorSource(): string;
+    setActorSource(value: string): AuditEntry;
+    getRequestId(): string;
+    setRequestId(value: string): AuditEntry;
+    clearRelationTupleDeltasList(): void;
+    getRelationTupleDeltasList(): Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>;
+    setRelationTupleDeltasList(value: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>): AuditEntry;
+    addRelationTupleDeltas(value?: ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta, index?: number): ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AuditEntry.AsObject;
+    static toObject(includeInstance: boolean, msg: AuditEntry): AuditEntry.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AuditEntry, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AuditEntry;
+    static deserializeBinaryFromReader(message: AuditEntry, reader: jspb.BinaryReader): AuditEntry;
+}
+
+export namespace AuditEntry {
+    export type AsObject = {
+        time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        operation: string,
+        actor: string,
+        actorSource: string,
+        requestId: string,
+        relationTupleDeltasList: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.AsObject>,
+    }
+}
@@ -0,0 +1,835 @@
+// source: ory/keto/relation_tuples/v1alpha2/audit_service.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global =
+    (typeof globalThis !== 'undefined' && globalThis) ||
+    (typeof window !== 'undefined' && window) ||
+    (typeof global !== 'undefined' && global) ||
+    (typeof self !== 'undefined' && self) ||
+    (function () { return this; }).call(null) ||
+    Function('return this')();
+
+var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
+goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_write_service_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.AuditEntry', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.AuditEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.AuditEntry';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    actor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    requestId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActor(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getActor();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string actor = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string request_id = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 page_size = 3;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string page_token = 4;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.AuditEntry;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated AuditEntry entries = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.AuditEntry, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.AuditEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListAuditEntriesResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    operation: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    actor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    actorSource: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    requestId: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    relationTupleDeltasList: jspb.Message.toObjectList(msg.getRelationTupleDeltasList(),
+    ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.AuditEntry;
+  return proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOperation(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActor(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActorSource(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestId(value);
+      break;
+    case 6:
+      var value = new ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.deserializeBinaryFromReader);
+      msg.addRelationTupleDeltas(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getOperation();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getActor();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getActorSource();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getRelationTupleDeltasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string operation = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getOperation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setOperation = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string actor = 3;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string actor_source = 4;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getActorSource = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setActorSource = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string request_id = 5;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated RelationTupleDelta relation_tuple_deltas = 6;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.getRelationTupleDeltasList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.setRelationTupleDeltasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.addRelationTupleDeltas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.AuditEntry} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.AuditEntry.prototype.clearRelationTupleDeltasList = function() {
+  return this.setRelationTupleDeltasList([]);
+};
+
+
+goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);
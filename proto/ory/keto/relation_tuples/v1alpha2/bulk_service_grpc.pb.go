@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: ory/keto/relation_tuples/v1alpha2/bulk_service.proto
+
+package rts
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BulkServiceClient is the client API for BulkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BulkServiceClient interface {
+	// Imports the streamed relationships. They are written in batches, each in
+	// its own transaction, so on error the batches before it stay written.
+	ImportRelationTuples(ctx context.Context, opts ...grpc.CallOption) (BulkService_ImportRelationTuplesClient, error)
+	// Streams all relationships of a namespace.
+	ExportRelationTuples(ctx context.Context, in *ExportRelationTuplesRequest, opts ...grpc.CallOption) (BulkService_ExportRelationTuplesClient, error)
+}
+
+type bulkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBulkServiceClient(cc grpc.ClientConnInterface) BulkServiceClient {
+	return &bulkServiceClient{cc}
+}
+
+func (c *bulkServiceClient) ImportRelationTuples(ctx context.Context, opts ...grpc.CallOption) (BulkService_ImportRelationTuplesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BulkService_ServiceDesc.Streams[0], "/ory.keto.relation_tuples.v1alpha2.BulkService/ImportRelationTuples", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bulkServiceImportRelationTuplesClient{stream}
+	return x, nil
+}
+
+type BulkService_ImportRelationTuplesClient interface {
+	Send(*ImportRelationTuplesRequest) error
+	CloseAndRecv() (*ImportRelationTuplesResponse, error)
+	grpc.ClientStream
+}
+
+type bulkServiceImportRelationTuplesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bulkServiceImportRelationTuplesClient) Send(m *ImportRelationTuplesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bulkServiceImportRelationTuplesClient) CloseAndRecv() (*ImportRelationTuplesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportRelationTuplesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bulkServiceClient) ExportRelationTuples(ctx context.Context, in *ExportRelationTuplesRequest, opts ...grpc.CallOption) (BulkService_ExportRelationTuplesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BulkService_ServiceDesc.Streams[1], "/ory.keto.relation_tuples.v1alpha2.BulkService/ExportRelationTuples", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bulkServiceExportRelationTuplesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BulkService_ExportRelationTuplesClient interface {
+	Recv() (*ExportRelationTuplesResponse, error)
+	grpc.ClientStream
+}
+
+type bulkServiceExportRelationTuplesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bulkServiceExportRelationTuplesClient) Recv() (*ExportRelationTuplesResponse, error) {
+	m := new(ExportRelationTuplesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BulkServiceServer is the server API for BulkService service.
+// All implementations should embed UnimplementedBulkServiceServer
+// for forward compatibility
+type BulkServiceServer interface {
+	// Imports the streamed relationships. They are written in batches, each in
+	// its own transaction, so on error the batches before it stay written.
+	ImportRelationTuples(BulkService_ImportRelationTuplesServer) error
+	// Streams all relationships of a namespace.
+	ExportRelationTuples(*ExportRelationTuplesRequest, BulkService_ExportRelationTuplesServer) error
+}
+
+// UnimplementedBulkServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBulkServiceServer struct {
+}
+
+func (UnimplementedBulkServiceServer) ImportRelationTuples(BulkService_ImportRelationTuplesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRelationTuples not implemented")
+}
+func (UnimplementedBulkServiceServer) ExportRelationTuples(*ExportRelationTuplesRequest, BulkService_ExportRelationTuplesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRelationTuples not implemented")
+}
+
+// UnsafeBulkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BulkServiceServer will
+// result in compilation errors.
+type UnsafeBulkServiceServer interface {
+	mustEmbedUnimplementedBulkServiceServer()
+}
+
+func RegisterBulkServiceServer(s grpc.ServiceRegistrar, srv BulkServiceServer) {
+	s.RegisterService(&BulkService_ServiceDesc, srv)
+}
+
+func _BulkService_ImportRelationTuples_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BulkServiceServer).ImportRelationTuples(&bulkServiceImportRelationTuplesServer{stream})
+}
+
+type BulkService_ImportRelationTuplesServer interface {
+	SendAndClose(*ImportRelationTuplesResponse) error
+	Recv() (*ImportRelationTuplesRequest, error)
+	grpc.ServerStream
+}
+
+type bulkServiceImportRelationTuplesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bulkServiceImportRelationTuplesServer) SendAndClose(m *ImportRelationTuplesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bulkServiceImportRelationTuplesServer) Recv() (*ImportRelationTuplesRequest, error) {
+	m := new(ImportRelationTuplesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BulkService_ExportRelationTuples_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRelationTuplesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BulkServiceServer).ExportRelationTuples(m, &bulkServiceExportRelationTuplesServer{stream})
+}
+
+type BulkService_ExportRelationTuplesServer interface {
+	Send(*ExportRelationTuplesResponse) error
+	grpc.ServerStream
+}
+
+type bulkServiceExportRelationTuplesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bulkServiceExportRelationTuplesServer) Send(m *ExportRelationTuplesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// BulkService_ServiceDesc is the grpc.ServiceDesc for BulkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BulkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ory.keto.relation_tuples.v1alpha2.BulkService",
+	HandlerType: (*BulkServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportRelationTuples",
+			Handler:       _BulkService_ImportRelationTuples_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportRelationTuples",
+			Handler:       _BulkService_ExportRelationTuples_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ory/keto/relation_tuples/v1alpha2/bulk_service.proto",
+}
@@ -0,0 +1,59 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/bulk_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "grpc";
+import * as ory_keto_relation_tuples_v1alpha2_bulk_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/bulk_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+
+interface IBulkServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    importRelationTuples: IBulkServiceService_IImportRelationTuples;
+    exportRelationTuples: IBulkServiceService_IExportRelationTuples;
+}
+
+interface IBulkServiceService_IImportRelationTuples extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.BulkService/ImportRelationTuples";
+    requestStream: true;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse>;
+}
+interface IBulkServiceService_IExportRelationTuples extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.BulkService/ExportRelationTuples";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+}
+
+export const BulkServiceService: IBulkServiceService;
+
+export interface IBulkServiceServer {
+    importRelationTuples: grpc.handleClientStreamingCall<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse>;
+    exportRelationTuples: grpc.handleServerStreamingCall<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+}
+
+export interface IBulkServiceClient {
+    importRelationTuples(callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    importRelationTuples(metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    importRelationTuples(options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    importRelationTuples(metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    exportRelationTuples(request: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+    exportRelationTuples(request: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+}
+
+export class BulkServiceClient extends grpc.Client implements IBulkServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public importRelationTuples(callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    public importRelationTuples(metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    public importRelationTuples(options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    public importRelationTuples(metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse) => void): grpc.ClientWritableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest>;
+    public exportRelationTuples(request: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+    public exportRelationTuples(request: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse>;
+}
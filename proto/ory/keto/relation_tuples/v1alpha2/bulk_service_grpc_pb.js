@@ -0,0 +1,84 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var ory_keto_relation_tuples_v1alpha2_bulk_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/bulk_service_pb.js');
+var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// The service to import and export large amounts of relationships.
+//
+// This service is part of the [write-APIs](../concepts/api-overview.mdx#write-apis).
+var BulkServiceService = exports.BulkServiceService = {
+  // Imports the streamed relationships. They are written in batches, each in
+  // its own transaction, so on error the batches before it stay written.
+importRelationTuples: {
+    path: '/ory.keto.relation_tuples.v1alpha2.BulkService/ImportRelationTuples',
+    requestStream: true,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ImportRelationTuplesResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ImportRelationTuplesResponse,
+  },
+  // Streams all relationships of a namespace.
+exportRelationTuples: {
+    path: '/ory.keto.relation_tuples.v1alpha2.BulkService/ExportRelationTuples',
+    requestStream: false,
+    responseStream: true,
+    requestType: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_bulk_service_pb.ExportRelationTuplesResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ExportRelationTuplesResponse,
+  },
+};
+
+exports.BulkServiceClient = grpc.makeGenericClientConstructor(BulkServiceService);
@@ -0,0 +1,101 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/bulk_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+
+export class ImportRelationTuplesRequest extends jspb.Message { 
+    clearRelationTuplesList(): void;
+    getRelationTuplesList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>;
+    setRelationTuplesList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>): ImportRelationTuplesRequest;
+    addRelationTuples(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ImportRelationTuplesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ImportRelationTuplesRequest): ImportRelationTuplesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ImportRelationTuplesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ImportRelationTuplesRequest;
+    static deserializeBinaryFromReader(message: ImportRelationTuplesRequest, reader: jspb.BinaryReader): ImportRelationTuplesRequest;
+}
+
+export namespace ImportRelationTuplesRequest {
+    export type AsObject = {
+        relationTuplesList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject>,
+    }
+}
+
+export class ImportRelationTuplesResponse extends jspb.Message { 
+    getImported(): number;
+    setImported(value: number): ImportRelationTuplesResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ImportRelationTuplesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ImportRelationTuplesResponse): ImportRelationTuplesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ImportRelationTuplesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ImportRelationTuplesResponse;
+    static deserializeBinaryFromReader(message: ImportRelationTuplesResponse, reader: jspb.BinaryReader): ImportRelationTuplesResponse;
+}
+
+export namespace ImportRelationTuplesResponse {
+    export type AsObject = {
+        imported: number,
+    }
+}
+
+export class ExportRelationTuplesRequest extends jspb.Message { 
+    getNamespace(): string;
+    setNamespace(value: string): ExportRelationTuplesRequest;
+    getCursor(): string;
+    setCursor(value: string): ExportRelationTuplesRequest;
+    getPageSize(): number;
+    setPageSize(value: number): ExportRelationTuplesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportRelationTuplesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportRelationTuplesRequest): ExportRelationTuplesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportRelationTuplesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportRelationTuplesRequest;
+    static deserializeBinaryFromReader(message: ExportRelationTuplesRequest, reader: jspb.BinaryReader): ExportRelationTuplesRequest;
+}
+
+export namespace ExportRelationTuplesRequest {
+    export type AsObject = {
+        namespace: string,
+        cursor: string,
+        pageSize: number,
+    }
+}
+
+export class ExportRelationTuplesResponse extends jspb.Message { 
+    clearRelationTuplesList(): void;
+    getRelationTuplesList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>;
+    setRelationTuplesList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>): ExportRelationTuplesResponse;
+    addRelationTuples(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+    getCursor(): string;
+    setCursor(value: string): ExportRelationTuplesResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportRelationTuplesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportRelationTuplesResponse): ExportRelationTuplesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportRelationTuplesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportRelationTuplesResponse;
+    static deserializeBinaryFromReader(message: ExportRelationTuplesResponse, reader: jspb.BinaryReader): ExportRelationTuplesResponse;
+}
+
+export namespace ExportRelationTuplesResponse {
+    export type AsObject = {
+        relationTuplesList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject>,
+        cursor: string,
+    }
+}
@@ -0,0 +1,784 @@
+// source: ory/keto/relation_tuples/v1alpha2/bulk_service.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global =
+    (typeof globalThis !== 'undefined' && globalThis) ||
+    (typeof window !== 'undefined' && window) ||
+    (typeof global !== 'undefined' && global) ||
+    (typeof self !== 'undefined' && self) ||
+    (function () { return this; }).call(null) ||
+    Function('return this')();
+
+var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
+goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    relationTuplesList: jspb.Message.toObjectList(msg.getRelationTuplesList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.addRelationTuples(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRelationTuplesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated RelationTuple relation_tuples = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.getRelationTuplesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.setRelationTuplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.addRelationTuples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTuple, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesRequest.prototype.clearRelationTuplesList = function() {
+  return this.setRelationTuplesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    imported: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setImported(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImported();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 imported = 1;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.prototype.getImported = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ImportRelationTuplesResponse.prototype.setImported = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespace: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string namespace = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string cursor = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 page_size = 3;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    relationTuplesList: jspb.Message.toObjectList(msg.getRelationTuplesList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject, includeInstance),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.addRelationTuples(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRelationTuplesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated RelationTuple relation_tuples = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.getRelationTuplesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.setRelationTuplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.addRelationTuples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTuple, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.clearRelationTuplesList = function() {
+  return this.setRelationTuplesList([]);
+};
+
+
+/**
+ * optional string cursor = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExportRelationTuplesResponse.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);
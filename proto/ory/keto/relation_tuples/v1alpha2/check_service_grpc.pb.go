@@ -24,6 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type CheckServiceClient interface {
 	// Performs an authorization check.
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// Evaluates the checks before and after applying the deltas, and returns the
+	// checks whose decision changes. The deltas are only applied in memory,
+	// nothing is written.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
 }
 
 type checkServiceClient struct {
@@ -43,12 +47,25 @@ func (c *checkServiceClient) Check(ctx context.Context, in *CheckRequest, opts .
 	return out, nil
 }
 
+func (c *checkServiceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/ory.keto.relation_tuples.v1alpha2.CheckService/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CheckServiceServer is the server API for CheckService service.
 // All implementations should embed UnimplementedCheckServiceServer
 // for forward compatibility
 type CheckServiceServer interface {
 	// Performs an authorization check.
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// Evaluates the checks before and after applying the deltas, and returns the
+	// checks whose decision changes. The deltas are only applied in memory,
+	// nothing is written.
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
 }
 
 // UnimplementedCheckServiceServer should be embedded to have forward compatible implementations.
@@ -58,6 +75,9 @@ type UnimplementedCheckServiceServer struct {
 func (UnimplementedCheckServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedCheckServiceServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
 
 // UnsafeCheckServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CheckServiceServer will
@@ -88,6 +108,24 @@ func _CheckService_Check_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckService_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ory.keto.relation_tuples.v1alpha2.CheckService/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckServiceServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CheckService_ServiceDesc is the grpc.ServiceDesc for CheckService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +137,10 @@ var CheckService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _CheckService_Check_Handler,
 		},
+		{
+			MethodName: "Simulate",
+			Handler:    _CheckService_Simulate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ory/keto/relation_tuples/v1alpha2/check_service.proto",
@@ -7,9 +7,12 @@
 import * as grpc from "grpc";
 import * as ory_keto_relation_tuples_v1alpha2_check_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/check_service_pb";
 import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 interface ICheckServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
     check: ICheckServiceService_ICheck;
+    simulate: ICheckServiceService_ISimulate;
 }
 
 interface ICheckServiceService_ICheck extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse> {
@@ -21,17 +24,30 @@ interface ICheckServiceService_ICheck extends grpc.MethodDefinition<ory_keto_rel
     responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse>;
     responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse>;
 }
+interface ICheckServiceService_ISimulate extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.CheckService/Simulate";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse>;
+}
 
 export const CheckServiceService: ICheckServiceService;
 
 export interface ICheckServiceServer {
     check: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse>;
+    simulate: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse>;
 }
 
 export interface ICheckServiceClient {
     check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
     check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
     check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
+    simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
+    simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
+    simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class CheckServiceClient extends grpc.Client implements ICheckServiceClient {
@@ -39,4 +55,7 @@ export class CheckServiceClient extends grpc.Client implements ICheckServiceClie
     public check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
     public check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
     public check(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse) => void): grpc.ClientUnaryCall;
+    public simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
+    public simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
+    public simulate(request: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse) => void): grpc.ClientUnaryCall;
 }
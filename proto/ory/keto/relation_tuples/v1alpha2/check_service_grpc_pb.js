@@ -4,6 +4,8 @@
 var grpc = require('@grpc/grpc-js');
 var ory_keto_relation_tuples_v1alpha2_check_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/check_service_pb.js');
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
+var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
 function serialize_ory_keto_relation_tuples_v1alpha2_CheckRequest(arg) {
   if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckRequest)) {
@@ -27,6 +29,28 @@ function deserialize_ory_keto_relation_tuples_v1alpha2_CheckResponse(buffer_arg)
   return ory_keto_relation_tuples_v1alpha2_check_service_pb.CheckResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_ory_keto_relation_tuples_v1alpha2_SimulateRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.SimulateRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_SimulateRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_SimulateResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.SimulateResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_SimulateResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 // The service that performs authorization checks
 // based on the stored Access Control Lists.
@@ -45,6 +69,20 @@ check: {
     responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_CheckResponse,
     responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_CheckResponse,
   },
+  // Evaluates the checks before and after applying the deltas, and returns the
+  // checks whose decision changes. The deltas are only applied in memory,
+  // nothing is written.
+simulate: {
+    path: '/ory.keto.relation_tuples.v1alpha2.CheckService/Simulate',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_check_service_pb.SimulateResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_SimulateRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_SimulateRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_SimulateResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_SimulateResponse,
+  },
 };
 
 exports.CheckServiceClient = grpc.makeGenericClientConstructor(CheckServiceService);
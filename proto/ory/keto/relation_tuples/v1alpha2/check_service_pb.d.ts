@@ -6,6 +6,8 @@
 
 import * as jspb from "google-protobuf";
 import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class CheckRequest extends jspb.Message { 
     getNamespace(): string;
@@ -31,6 +33,11 @@ export class CheckRequest extends jspb.Message {
     getMaxDepth(): number;
     setMaxDepth(value: number): CheckRequest;
 
+    hasAsOf(): boolean;
+    clearAsOf(): void;
+    getAsOf(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setAsOf(value?: google_protobuf_timestamp_pb.Timestamp): CheckRequest;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): CheckRequest.AsObject;
     static toObject(includeInstance: boolean, msg: CheckRequest): CheckRequest.AsObject;
@@ -51,6 +58,7 @@ export namespace CheckRequest {
         latest: boolean,
         snaptoken: string,
         maxDepth: number,
+        asOf?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -59,6 +67,8 @@ export class CheckResponse extends jspb.Message {
     setAllowed(value: boolean): CheckResponse;
     getSnaptoken(): string;
     setSnaptoken(value: string): CheckResponse;
+    getSchemaHash(): string;
+    setSchemaHash(value: string): CheckResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): CheckResponse.AsObject;
@@ -74,5 +84,87 @@ export namespace CheckResponse {
     export type AsObject = {
         allowed: boolean,
         snaptoken: string,
+        schemaHash: string,
+    }
+}
+
+export class SimulateRequest extends jspb.Message { 
+    clearRelationTupleDeltasList(): void;
+    getRelationTupleDeltasList(): Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>;
+    setRelationTupleDeltasList(value: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>): SimulateRequest;
+    addRelationTupleDeltas(value?: ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta, index?: number): ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta;
+    clearChecksList(): void;
+    getChecksList(): Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>;
+    setChecksList(value: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple>): SimulateRequest;
+    addChecks(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, index?: number): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+    getMaxDepth(): number;
+    setMaxDepth(value: number): SimulateRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SimulateRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SimulateRequest): SimulateRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SimulateRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SimulateRequest;
+    static deserializeBinaryFromReader(message: SimulateRequest, reader: jspb.BinaryReader): SimulateRequest;
+}
+
+export namespace SimulateRequest {
+    export type AsObject = {
+        relationTupleDeltasList: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.AsObject>,
+        checksList: Array<ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject>,
+        maxDepth: number,
+    }
+}
+
+export class SimulateResponse extends jspb.Message { 
+    clearFlippedList(): void;
+    getFlippedList(): Array<SimulatedCheck>;
+    setFlippedList(value: Array<SimulatedCheck>): SimulateResponse;
+    addFlipped(value?: SimulatedCheck, index?: number): SimulatedCheck;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SimulateResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SimulateResponse): SimulateResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SimulateResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SimulateResponse;
+    static deserializeBinaryFromReader(message: SimulateResponse, reader: jspb.BinaryReader): SimulateResponse;
+}
+
+export namespace SimulateResponse {
+    export type AsObject = {
+        flippedList: Array<SimulatedCheck.AsObject>,
+    }
+}
+
+export class SimulatedCheck extends jspb.Message { 
+
+    hasTuple(): boolean;
+    clearTuple(): void;
+    getTuple(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple | undefined;
+    setTuple(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple): SimulatedCheck;
+    getAllowedBefore(): boolean;
+    setAllowedBefore(value: boolean): SimulatedCheck;
+    getAllowedAfter(): boolean;
+    setAllowedAfter(value: boolean): SimulatedCheck;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SimulatedCheck.AsObject;
+    static toObject(includeInstance: boolean, msg: SimulatedCheck): SimulatedCheck.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SimulatedCheck, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SimulatedCheck;
+    static deserializeBinaryFromReader(message: SimulatedCheck, reader: jspb.BinaryReader): SimulatedCheck;
+}
+
+export namespace SimulatedCheck {
+    export type AsObject = {
+        tuple?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject,
+        allowedBefore: boolean,
+        allowedAfter: boolean,
     }
 }
@@ -23,8 +23,15 @@ var global =
 
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
 goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
+var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
+goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_write_service_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.CheckRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.CheckResponse', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -67,6 +74,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.CheckResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck';
+}
 
 
 
@@ -106,7 +176,8 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.toObject = function(include
     tuple: (f = msg.getTuple()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f),
     latest: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
     snaptoken: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    maxDepth: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    maxDepth: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    asOf: (f = msg.getAsOf()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -177,6 +248,11 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.deserializeBinaryFromReader
       var value = /** @type {number} */ (reader.readInt32());
       msg.setMaxDepth(value);
       break;
+    case 9:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAsOf(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -264,6 +340,14 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getAsOf();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -449,6 +533,43 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.prototype.setMaxDepth = fun
 };
 
 
+/**
+ * optional google.protobuf.Timestamp as_of = 9;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.prototype.getAsOf = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.CheckRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.prototype.setAsOf = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.CheckRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.prototype.clearAsOf = function() {
+  return this.setAsOf(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.CheckRequest.prototype.hasAsOf = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
 
 
 
@@ -482,7 +603,8 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.prototype.toObject = funct
 proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     allowed: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    snaptoken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    snaptoken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    schemaHash: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -527,6 +649,10 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.deserializeBinaryFromReade
       var value = /** @type {string} */ (reader.readString());
       msg.setSnaptoken(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSchemaHash(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -570,6 +696,13 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.serializeBinaryToWriter =
       f
     );
   }
+  f = message.getSchemaHash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -609,4 +742,636 @@ proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.prototype.setSnaptoken = f
 };
 
 
+/**
+ * optional string schema_hash = 3;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.prototype.getSchemaHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.CheckResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.CheckResponse.prototype.setSchemaHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    relationTupleDeltasList: jspb.Message.toObjectList(msg.getRelationTupleDeltasList(),
+    ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.toObject, includeInstance),
+    checksList: jspb.Message.toObjectList(msg.getChecksList(),
+    ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject, includeInstance),
+    maxDepth: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.deserializeBinaryFromReader);
+      msg.addRelationTupleDeltas(value);
+      break;
+    case 2:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.addChecks(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxDepth(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRelationTupleDeltasList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.serializeBinaryToWriter
+    );
+  }
+  f = message.getChecksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxDepth();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated RelationTupleDelta relation_tuple_deltas = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.getRelationTupleDeltasList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.setRelationTupleDeltasList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.addRelationTupleDeltas = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.clearRelationTupleDeltasList = function() {
+  return this.setRelationTupleDeltasList([]);
+};
+
+
+/**
+ * repeated RelationTuple checks = 2;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.getChecksList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} */ (
+    jspb.Message.getRepeatedWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.setChecksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.addChecks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationTuple, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.clearChecksList = function() {
+  return this.setChecksList([]);
+};
+
+
+/**
+ * optional int32 max_depth = 3;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.getMaxDepth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateRequest.prototype.setMaxDepth = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    flippedList: jspb.Message.toObjectList(msg.getFlippedList(),
+    proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.deserializeBinaryFromReader);
+      msg.addFlipped(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFlippedList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated SimulatedCheck flipped = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.getFlippedList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.setFlippedList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.addFlipped = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulateResponse.prototype.clearFlippedList = function() {
+  return this.setFlippedList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tuple: (f = msg.getTuple()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f),
+    allowedBefore: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    allowedAfter: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck;
+  return proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.setTuple(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowedBefore(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowedAfter(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTuple();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+  f = message.getAllowedBefore();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getAllowedAfter();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional RelationTuple tuple = 1;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.getTuple = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.setTuple = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.clearTuple = function() {
+  return this.setTuple(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.hasTuple = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bool allowed_before = 2;
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.getAllowedBefore = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.setAllowedBefore = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool allowed_after = 3;
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.getAllowedAfter = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SimulatedCheck.prototype.setAllowedAfter = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);
@@ -7,6 +7,7 @@
 import * as grpc from "grpc";
 import * as ory_keto_relation_tuples_v1alpha2_expand_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/expand_service_pb";
 import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 interface IExpandServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
     expand: IExpandServiceService_IExpand;
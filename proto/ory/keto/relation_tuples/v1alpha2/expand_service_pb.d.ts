@@ -6,6 +6,7 @@
 
 import * as jspb from "google-protobuf";
 import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class ExpandRequest extends jspb.Message { 
 
@@ -18,6 +19,11 @@ export class ExpandRequest extends jspb.Message {
     getSnaptoken(): string;
     setSnaptoken(value: string): ExpandRequest;
 
+    hasAsOf(): boolean;
+    clearAsOf(): void;
+    getAsOf(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setAsOf(value?: google_protobuf_timestamp_pb.Timestamp): ExpandRequest;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ExpandRequest.AsObject;
     static toObject(includeInstance: boolean, msg: ExpandRequest): ExpandRequest.AsObject;
@@ -33,6 +39,7 @@ export namespace ExpandRequest {
         subject?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.Subject.AsObject,
         maxDepth: number,
         snaptoken: string,
+        asOf?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
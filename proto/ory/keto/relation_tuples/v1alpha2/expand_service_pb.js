@@ -23,6 +23,8 @@ var global =
 
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
 goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ExpandResponse', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.NodeType', null, global);
@@ -124,7 +126,8 @@ proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.toObject = function(includ
   var f, obj = {
     subject: (f = msg.getSubject()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.Subject.toObject(includeInstance, f),
     maxDepth: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    snaptoken: jspb.Message.getFieldWithDefault(msg, 3, "")
+    snaptoken: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    asOf: (f = msg.getAsOf()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -174,6 +177,11 @@ proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.deserializeBinaryFromReade
       var value = /** @type {string} */ (reader.readString());
       msg.setSnaptoken(value);
       break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAsOf(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -225,6 +233,14 @@ proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.serializeBinaryToWriter =
       f
     );
   }
+  f = message.getAsOf();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -301,6 +317,43 @@ proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.prototype.setSnaptoken = f
 };
 
 
+/**
+ * optional google.protobuf.Timestamp as_of = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.prototype.getAsOf = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.prototype.setAsOf = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.prototype.clearAsOf = function() {
+  return this.setAsOf(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ExpandRequest.prototype.hasAsOf = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
 
 
 
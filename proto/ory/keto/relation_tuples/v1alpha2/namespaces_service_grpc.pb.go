@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type NamespacesServiceClient interface {
 	// Lists Namespaces
 	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// Describes the relations, subject types, and permit rewrites of namespaces.
+	DescribeNamespaces(ctx context.Context, in *DescribeNamespacesRequest, opts ...grpc.CallOption) (*DescribeNamespacesResponse, error)
 }
 
 type namespacesServiceClient struct {
@@ -43,12 +45,23 @@ func (c *namespacesServiceClient) ListNamespaces(ctx context.Context, in *ListNa
 	return out, nil
 }
 
+func (c *namespacesServiceClient) DescribeNamespaces(ctx context.Context, in *DescribeNamespacesRequest, opts ...grpc.CallOption) (*DescribeNamespacesResponse, error) {
+	out := new(DescribeNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/ory.keto.relation_tuples.v1alpha2.NamespacesService/DescribeNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamespacesServiceServer is the server API for NamespacesService service.
 // All implementations should embed UnimplementedNamespacesServiceServer
 // for forward compatibility
 type NamespacesServiceServer interface {
 	// Lists Namespaces
 	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// Describes the relations, subject types, and permit rewrites of namespaces.
+	DescribeNamespaces(context.Context, *DescribeNamespacesRequest) (*DescribeNamespacesResponse, error)
 }
 
 // UnimplementedNamespacesServiceServer should be embedded to have forward compatible implementations.
@@ -58,6 +71,9 @@ type UnimplementedNamespacesServiceServer struct {
 func (UnimplementedNamespacesServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
 }
+func (UnimplementedNamespacesServiceServer) DescribeNamespaces(context.Context, *DescribeNamespacesRequest) (*DescribeNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaces not implemented")
+}
 
 // UnsafeNamespacesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NamespacesServiceServer will
@@ -88,6 +104,24 @@ func _NamespacesService_ListNamespaces_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespacesService_DescribeNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespacesServiceServer).DescribeNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ory.keto.relation_tuples.v1alpha2.NamespacesService/DescribeNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespacesServiceServer).DescribeNamespaces(ctx, req.(*DescribeNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NamespacesService_ServiceDesc is the grpc.ServiceDesc for NamespacesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +133,10 @@ var NamespacesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNamespaces",
 			Handler:    _NamespacesService_ListNamespaces_Handler,
 		},
+		{
+			MethodName: "DescribeNamespaces",
+			Handler:    _NamespacesService_DescribeNamespaces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ory/keto/relation_tuples/v1alpha2/namespaces_service.proto",
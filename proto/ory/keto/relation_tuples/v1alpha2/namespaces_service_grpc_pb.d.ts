@@ -9,6 +9,7 @@ import * as ory_keto_relation_tuples_v1alpha2_namespaces_service_pb from "../../
 
 interface INamespacesServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
     listNamespaces: INamespacesServiceService_IListNamespaces;
+    describeNamespaces: INamespacesServiceService_IDescribeNamespaces;
 }
 
 interface INamespacesServiceService_IListNamespaces extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse> {
@@ -20,17 +21,30 @@ interface INamespacesServiceService_IListNamespaces extends grpc.MethodDefinitio
     responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse>;
     responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse>;
 }
+interface INamespacesServiceService_IDescribeNamespaces extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.NamespacesService/DescribeNamespaces";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse>;
+}
 
 export const NamespacesServiceService: INamespacesServiceService;
 
 export interface INamespacesServiceServer {
     listNamespaces: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse>;
+    describeNamespaces: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse>;
 }
 
 export interface INamespacesServiceClient {
     listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
     listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
     listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
+    describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
+    describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
+    describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class NamespacesServiceClient extends grpc.Client implements INamespacesServiceClient {
@@ -38,4 +52,7 @@ export class NamespacesServiceClient extends grpc.Client implements INamespacesS
     public listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
     public listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
     public listNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesResponse) => void): grpc.ClientUnaryCall;
+    public describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
+    public describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
+    public describeNamespaces(request: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse) => void): grpc.ClientUnaryCall;
 }
@@ -4,6 +4,28 @@
 var grpc = require('@grpc/grpc-js');
 var ory_keto_relation_tuples_v1alpha2_namespaces_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/namespaces_service_pb.js');
 
+function serialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_ory_keto_relation_tuples_v1alpha2_ListNamespacesRequest(arg) {
   if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.ListNamespacesRequest)) {
     throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.ListNamespacesRequest');
@@ -43,6 +65,18 @@ listNamespaces: {
     responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_ListNamespacesResponse,
     responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_ListNamespacesResponse,
   },
+  // Describes the relations, subject types, and permit rewrites of namespaces.
+describeNamespaces: {
+    path: '/ory.keto.relation_tuples.v1alpha2.NamespacesService/DescribeNamespaces',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_namespaces_service_pb.DescribeNamespacesResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_DescribeNamespacesResponse,
+  },
 };
 
 exports.NamespacesServiceClient = grpc.makeGenericClientConstructor(NamespacesServiceService);
@@ -64,3 +64,178 @@ export namespace Namespace {
         name: string,
     }
 }
+
+export class DescribeNamespacesRequest extends jspb.Message { 
+    getNamespace(): string;
+    setNamespace(value: string): DescribeNamespacesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DescribeNamespacesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DescribeNamespacesRequest): DescribeNamespacesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DescribeNamespacesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DescribeNamespacesRequest;
+    static deserializeBinaryFromReader(message: DescribeNamespacesRequest, reader: jspb.BinaryReader): DescribeNamespacesRequest;
+}
+
+export namespace DescribeNamespacesRequest {
+    export type AsObject = {
+        namespace: string,
+    }
+}
+
+export class DescribeNamespacesResponse extends jspb.Message { 
+    clearNamespacesList(): void;
+    getNamespacesList(): Array<NamespaceSchema>;
+    setNamespacesList(value: Array<NamespaceSchema>): DescribeNamespacesResponse;
+    addNamespaces(value?: NamespaceSchema, index?: number): NamespaceSchema;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DescribeNamespacesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DescribeNamespacesResponse): DescribeNamespacesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DescribeNamespacesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DescribeNamespacesResponse;
+    static deserializeBinaryFromReader(message: DescribeNamespacesResponse, reader: jspb.BinaryReader): DescribeNamespacesResponse;
+}
+
+export namespace DescribeNamespacesResponse {
+    export type AsObject = {
+        namespacesList: Array<NamespaceSchema.AsObject>,
+    }
+}
+
+export class NamespaceSchema extends jspb.Message { 
+    getName(): string;
+    setName(value: string): NamespaceSchema;
+    clearRelationsList(): void;
+    getRelationsList(): Array<NamespaceRelation>;
+    setRelationsList(value: Array<NamespaceRelation>): NamespaceSchema;
+    addRelations(value?: NamespaceRelation, index?: number): NamespaceRelation;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): NamespaceSchema.AsObject;
+    static toObject(includeInstance: boolean, msg: NamespaceSchema): NamespaceSchema.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: NamespaceSchema, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): NamespaceSchema;
+    static deserializeBinaryFromReader(message: NamespaceSchema, reader: jspb.BinaryReader): NamespaceSchema;
+}
+
+export namespace NamespaceSchema {
+    export type AsObject = {
+        name: string,
+        relationsList: Array<NamespaceRelation.AsObject>,
+    }
+}
+
+export class NamespaceRelation extends jspb.Message { 
+    getName(): string;
+    setName(value: string): NamespaceRelation;
+    clearTypesList(): void;
+    getTypesList(): Array<RelationType>;
+    setTypesList(value: Array<RelationType>): NamespaceRelation;
+    addTypes(value?: RelationType, index?: number): RelationType;
+
+    hasRewrite(): boolean;
+    clearRewrite(): void;
+    getRewrite(): RewriteNode | undefined;
+    setRewrite(value?: RewriteNode): NamespaceRelation;
+    getDescription(): string;
+    setDescription(value: string): NamespaceRelation;
+    getDeprecated(): boolean;
+    setDeprecated(value: boolean): NamespaceRelation;
+    getDeprecationReason(): string;
+    setDeprecationReason(value: string): NamespaceRelation;
+    clearSubjectTypesList(): void;
+    getSubjectTypesList(): Array<RelationType>;
+    setSubjectTypesList(value: Array<RelationType>): NamespaceRelation;
+    addSubjectTypes(value?: RelationType, index?: number): RelationType;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): NamespaceRelation.AsObject;
+    static toObject(includeInstance: boolean, msg: NamespaceRelation): NamespaceRelation.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: NamespaceRelation, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): NamespaceRelation;
+    static deserializeBinaryFromReader(message: NamespaceRelation, reader: jspb.BinaryReader): NamespaceRelation;
+}
+
+export namespace NamespaceRelation {
+    export type AsObject = {
+        name: string,
+        typesList: Array<RelationType.AsObject>,
+        rewrite?: RewriteNode.AsObject,
+        description: string,
+        deprecated: boolean,
+        deprecationReason: string,
+        subjectTypesList: Array<RelationType.AsObject>,
+    }
+}
+
+export class RelationType extends jspb.Message { 
+    getNamespace(): string;
+    setNamespace(value: string): RelationType;
+    getRelation(): string;
+    setRelation(value: string): RelationType;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RelationType.AsObject;
+    static toObject(includeInstance: boolean, msg: RelationType): RelationType.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RelationType, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RelationType;
+    static deserializeBinaryFromReader(message: RelationType, reader: jspb.BinaryReader): RelationType;
+}
+
+export namespace RelationType {
+    export type AsObject = {
+        namespace: string,
+        relation: string,
+    }
+}
+
+export class RewriteNode extends jspb.Message { 
+    getType(): RewriteNodeType;
+    setType(value: RewriteNodeType): RewriteNode;
+    getRelation(): string;
+    setRelation(value: string): RewriteNode;
+    getComputedSubjectSetRelation(): string;
+    setComputedSubjectSetRelation(value: string): RewriteNode;
+    clearChildrenList(): void;
+    getChildrenList(): Array<RewriteNode>;
+    setChildrenList(value: Array<RewriteNode>): RewriteNode;
+    addChildren(value?: RewriteNode, index?: number): RewriteNode;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RewriteNode.AsObject;
+    static toObject(includeInstance: boolean, msg: RewriteNode): RewriteNode.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RewriteNode, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RewriteNode;
+    static deserializeBinaryFromReader(message: RewriteNode, reader: jspb.BinaryReader): RewriteNode;
+}
+
+export namespace RewriteNode {
+    export type AsObject = {
+        type: RewriteNodeType,
+        relation: string,
+        computedSubjectSetRelation: string,
+        childrenList: Array<RewriteNode.AsObject>,
+    }
+}
+
+export enum RewriteNodeType {
+    REWRITE_NODE_TYPE_UNSPECIFIED = 0,
+    REWRITE_NODE_TYPE_UNION = 1,
+    REWRITE_NODE_TYPE_INTERSECTION = 2,
+    REWRITE_NODE_TYPE_COMPUTED_SUBJECT_SET = 3,
+    REWRITE_NODE_TYPE_TUPLE_TO_SUBJECT_SET = 4,
+    REWRITE_NODE_TYPE_NOT = 5,
+}
@@ -21,9 +21,16 @@ var global =
     (function () { return this; }).call(null) ||
     Function('return this')();
 
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListNamespacesRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListNamespacesResponse', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.Namespace', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RelationType', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RewriteNode', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -87,6 +94,132 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ory.keto.relation_tuples.v1alpha2.Namespace.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.Namespace';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.RelationType, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.RelationType.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.RelationType';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.repeatedFields_, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.RewriteNode, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.RewriteNode';
+}
 
 
 
@@ -478,4 +611,1290 @@ proto.ory.keto.relation_tuples.v1alpha2.Namespace.prototype.setName = function(v
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespace: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string namespace = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesRequest.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespacesList: jspb.Message.toObjectList(msg.getNamespacesList(),
+    proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.deserializeBinaryFromReader);
+      msg.addNamespaces(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespacesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated NamespaceSchema namespaces = 1;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.getNamespacesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.setNamespacesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.addNamespaces = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DescribeNamespacesResponse.prototype.clearNamespacesList = function() {
+  return this.setNamespacesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    relationsList: jspb.Message.toObjectList(msg.getRelationsList(),
+    proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema;
+  return proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.deserializeBinaryFromReader);
+      msg.addRelations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRelationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated NamespaceRelation relations = 2;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.getRelationsList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.setRelationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.addRelations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceSchema.prototype.clearRelationsList = function() {
+  return this.setRelationsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.repeatedFields_ = [2,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    typesList: jspb.Message.toObjectList(msg.getTypesList(),
+    proto.ory.keto.relation_tuples.v1alpha2.RelationType.toObject, includeInstance),
+    rewrite: (f = msg.getRewrite()) && proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.toObject(includeInstance, f),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    deprecated: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    deprecationReason: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    subjectTypesList: jspb.Message.toObjectList(msg.getSubjectTypesList(),
+    proto.ory.keto.relation_tuples.v1alpha2.RelationType.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation;
+  return proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.RelationType;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.RelationType.deserializeBinaryFromReader);
+      msg.addTypes(value);
+      break;
+    case 3:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.RewriteNode;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.deserializeBinaryFromReader);
+      msg.setRewrite(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeprecated(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeprecationReason(value);
+      break;
+    case 7:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.RelationType;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.RelationType.deserializeBinaryFromReader);
+      msg.addSubjectTypes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.RelationType.serializeBinaryToWriter
+    );
+  }
+  f = message.getRewrite();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.serializeBinaryToWriter
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDeprecated();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getDeprecationReason();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getSubjectTypesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.RelationType.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated RelationType types = 2;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getTypesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.RelationType, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setTypesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationType=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.addTypes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationType, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.clearTypesList = function() {
+  return this.setTypesList([]);
+};
+
+
+/**
+ * optional RewriteNode rewrite = 3;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RewriteNode}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getRewrite = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} */ (
+    jspb.Message.getWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.RewriteNode, 3));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RewriteNode|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setRewrite = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.clearRewrite = function() {
+  return this.setRewrite(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.hasRewrite = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string description = 4;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool deprecated = 5;
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getDeprecated = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setDeprecated = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional string deprecation_reason = 6;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getDeprecationReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setDeprecationReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * repeated RelationType subject_types = 7;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.getSubjectTypesList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.RelationType, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RelationType>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.setSubjectTypesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationType=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.addSubjectTypes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RelationType, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.NamespaceRelation.prototype.clearSubjectTypesList = function() {
+  return this.setSubjectTypesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.RelationType.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationType} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespace: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    relation: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RelationType;
+  return proto.ory.keto.relation_tuples.v1alpha2.RelationType.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationType} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelation(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.RelationType.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationType} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRelation();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string namespace = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string relation = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.getRelation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationType} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationType.prototype.setRelation = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    relation: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    computedSubjectSetRelation: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
+    proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RewriteNode;
+  return proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelation(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComputedSubjectSetRelation(value);
+      break;
+    case 4:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.RewriteNode;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.deserializeBinaryFromReader);
+      msg.addChildren(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getRelation();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getComputedSubjectSetRelation();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getChildrenList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional RewriteNodeType type = 1;
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.getType = function() {
+  return /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string relation = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.getRelation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.setRelation = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string computed_subject_set_relation = 3;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.getComputedSubjectSetRelation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.setComputedSubjectSetRelation = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated RewriteNode children = 4;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.getChildrenList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.RewriteNode, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.setChildrenList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.addChildren = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.ory.keto.relation_tuples.v1alpha2.RewriteNode, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RewriteNode} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNode.prototype.clearChildrenList = function() {
+  return this.setChildrenList([]);
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RewriteNodeType = {
+  REWRITE_NODE_TYPE_UNSPECIFIED: 0,
+  REWRITE_NODE_TYPE_UNION: 1,
+  REWRITE_NODE_TYPE_INTERSECTION: 2,
+  REWRITE_NODE_TYPE_COMPUTED_SUBJECT_SET: 3,
+  REWRITE_NODE_TYPE_TUPLE_TO_SUBJECT_SET: 4,
+  REWRITE_NODE_TYPE_NOT: 5
+};
+
 goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);
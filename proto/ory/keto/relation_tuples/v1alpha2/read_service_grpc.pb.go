@@ -22,7 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ReadServiceClient interface {
-	// Lists ACL relation tuples.
+	// Lists ACL relationships.
 	ListRelationTuples(ctx context.Context, in *ListRelationTuplesRequest, opts ...grpc.CallOption) (*ListRelationTuplesResponse, error)
 }
 
@@ -47,7 +47,7 @@ func (c *readServiceClient) ListRelationTuples(ctx context.Context, in *ListRela
 // All implementations should embed UnimplementedReadServiceServer
 // for forward compatibility
 type ReadServiceServer interface {
-	// Lists ACL relation tuples.
+	// Lists ACL relationships.
 	ListRelationTuples(context.Context, *ListRelationTuplesRequest) (*ListRelationTuplesResponse, error)
 }
 
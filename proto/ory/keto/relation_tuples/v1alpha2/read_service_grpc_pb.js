@@ -5,6 +5,7 @@ var grpc = require('@grpc/grpc-js');
 var ory_keto_relation_tuples_v1alpha2_read_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/read_service_pb.js');
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
 var google_protobuf_field_mask_pb = require('google-protobuf/google/protobuf/field_mask_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
 function serialize_ory_keto_relation_tuples_v1alpha2_ListRelationTuplesRequest(arg) {
   if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_read_service_pb.ListRelationTuplesRequest)) {
@@ -29,11 +30,11 @@ function deserialize_ory_keto_relation_tuples_v1alpha2_ListRelationTuplesRespons
 }
 
 
-// The service to query relation tuples.
+// The service to query relationships.
 //
 // This service is part of the [read-APIs](../concepts/api-overview.mdx#read-apis).
 var ReadServiceService = exports.ReadServiceService = {
-  // Lists ACL relation tuples.
+  // Lists ACL relationships.
 listRelationTuples: {
     path: '/ory.keto.relation_tuples.v1alpha2.ReadService/ListRelationTuples',
     requestStream: false,
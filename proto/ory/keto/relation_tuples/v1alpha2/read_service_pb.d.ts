@@ -7,6 +7,7 @@
 import * as jspb from "google-protobuf";
 import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb";
 import * as google_protobuf_field_mask_pb from "google-protobuf/google/protobuf/field_mask_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class ListRelationTuplesRequest extends jspb.Message { 
 
@@ -31,6 +32,11 @@ export class ListRelationTuplesRequest extends jspb.Message {
     getPageToken(): string;
     setPageToken(value: string): ListRelationTuplesRequest;
 
+    hasAsOf(): boolean;
+    clearAsOf(): void;
+    getAsOf(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setAsOf(value?: google_protobuf_timestamp_pb.Timestamp): ListRelationTuplesRequest;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ListRelationTuplesRequest.AsObject;
     static toObject(includeInstance: boolean, msg: ListRelationTuplesRequest): ListRelationTuplesRequest.AsObject;
@@ -49,6 +55,7 @@ export namespace ListRelationTuplesRequest {
         snaptoken: string,
         pageSize: number,
         pageToken: string,
+        asOf?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 
 
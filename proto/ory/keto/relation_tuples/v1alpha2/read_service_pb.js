@@ -25,6 +25,8 @@ var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../
 goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
 var google_protobuf_field_mask_pb = require('google-protobuf/google/protobuf/field_mask_pb.js');
 goog.object.extend(proto, google_protobuf_field_mask_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.Query', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesResponse', null, global);
@@ -128,7 +130,8 @@ proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.toObject = fun
     expandMask: (f = msg.getExpandMask()) && google_protobuf_field_mask_pb.FieldMask.toObject(includeInstance, f),
     snaptoken: jspb.Message.getFieldWithDefault(msg, 3, ""),
     pageSize: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    pageToken: jspb.Message.getFieldWithDefault(msg, 5, "")
+    pageToken: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    asOf: (f = msg.getAsOf()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -192,6 +195,11 @@ proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.deserializeBin
       var value = /** @type {string} */ (reader.readString());
       msg.setPageToken(value);
       break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAsOf(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -266,6 +274,14 @@ proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.serializeBinar
       f
     );
   }
+  f = message.getAsOf();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -675,6 +691,43 @@ proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.prototype.setP
 };
 
 
+/**
+ * optional google.protobuf.Timestamp as_of = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.prototype.getAsOf = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.prototype.setAsOf = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.prototype.clearAsOf = function() {
+  return this.setAsOf(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.ListRelationTuplesRequest.prototype.hasAsOf = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
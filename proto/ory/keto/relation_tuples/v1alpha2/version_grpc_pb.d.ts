@@ -6,6 +6,7 @@
 
 import * as grpc from "grpc";
 import * as ory_keto_relation_tuples_v1alpha2_version_pb from "../../../../ory/keto/relation_tuples/v1alpha2/version_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 interface IVersionServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
     getVersion: IVersionServiceService_IGetVersion;
@@ -5,6 +5,7 @@
 /* eslint-disable */
 
 import * as jspb from "google-protobuf";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class GetVersionRequest extends jspb.Message { 
 
@@ -27,6 +28,11 @@ export class GetVersionResponse extends jspb.Message {
     getVersion(): string;
     setVersion(value: string): GetVersionResponse;
 
+    hasSchema(): boolean;
+    clearSchema(): void;
+    getSchema(): SchemaStatus | undefined;
+    setSchema(value?: SchemaStatus): GetVersionResponse;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetVersionResponse.AsObject;
     static toObject(includeInstance: boolean, msg: GetVersionResponse): GetVersionResponse.AsObject;
@@ -40,5 +46,44 @@ export class GetVersionResponse extends jspb.Message {
 export namespace GetVersionResponse {
     export type AsObject = {
         version: string,
+        schema?: SchemaStatus.AsObject,
+    }
+}
+
+export class SchemaStatus extends jspb.Message { 
+    getHash(): string;
+    setHash(value: string): SchemaStatus;
+    getVersion(): number;
+    setVersion(value: number): SchemaStatus;
+
+    hasLoadedAt(): boolean;
+    clearLoadedAt(): void;
+    getLoadedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setLoadedAt(value?: google_protobuf_timestamp_pb.Timestamp): SchemaStatus;
+    getLastError(): string;
+    setLastError(value: string): SchemaStatus;
+
+    hasLastErrorAt(): boolean;
+    clearLastErrorAt(): void;
+    getLastErrorAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setLastErrorAt(value?: google_protobuf_timestamp_pb.Timestamp): SchemaStatus;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SchemaStatus.AsObject;
+    static toObject(includeInstance: boolean, msg: SchemaStatus): SchemaStatus.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SchemaStatus, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SchemaStatus;
+    static deserializeBinaryFromReader(message: SchemaStatus, reader: jspb.BinaryReader): SchemaStatus;
+}
+
+export namespace SchemaStatus {
+    export type AsObject = {
+        hash: string,
+        version: number,
+        loadedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        lastError: string,
+        lastErrorAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
@@ -21,8 +21,11 @@ var global =
     (function () { return this; }).call(null) ||
     Function('return this')();
 
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.GetVersionRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -65,6 +68,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus';
+}
 
 
 
@@ -198,7 +222,8 @@ proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.toObject =
  */
 proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    version: jspb.Message.getFieldWithDefault(msg, 1, "")
+    version: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    schema: (f = msg.getSchema()) && proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -239,6 +264,11 @@ proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.deserializeBinaryFrom
       var value = /** @type {string} */ (reader.readString());
       msg.setVersion(value);
       break;
+    case 2:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.deserializeBinaryFromReader);
+      msg.setSchema(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -275,6 +305,14 @@ proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.serializeBinaryToWrit
       f
     );
   }
+  f = message.getSchema();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -296,4 +334,333 @@ proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.setVersion
 };
 
 
+/**
+ * optional SchemaStatus schema = 2;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.getSchema = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} */ (
+    jspb.Message.getWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus, 2));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.setSchema = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.clearSchema = function() {
+  return this.setSchema(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.GetVersionResponse.prototype.hasSchema = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    hash: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    loadedAt: (f = msg.getLoadedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastError: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    lastErrorAt: (f = msg.getLastErrorAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus;
+  return proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHash(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLoadedAt(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastError(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastErrorAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHash();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getLoadedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastError();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getLastErrorAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string hash = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.getHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.setHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 version = 2;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp loaded_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.getLoadedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.setLoadedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.clearLoadedAt = function() {
+  return this.setLoadedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.hasLoadedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string last_error = 4;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.getLastError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.setLastError = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_error_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.getLastErrorAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.setLastErrorAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.clearLastErrorAt = function() {
+  return this.setLastErrorAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.SchemaStatus.prototype.hasLastErrorAt = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);
@@ -0,0 +1,41 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/watch_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "grpc";
+import * as ory_keto_relation_tuples_v1alpha2_watch_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/watch_service_pb";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+interface IWatchServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    watch: IWatchServiceService_IWatch;
+}
+
+interface IWatchServiceService_IWatch extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.WatchService/Watch";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+}
+
+export const WatchServiceService: IWatchServiceService;
+
+export interface IWatchServiceServer {
+    watch: grpc.handleServerStreamingCall<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+}
+
+export interface IWatchServiceClient {
+    watch(request: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+    watch(request: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+}
+
+export class WatchServiceClient extends grpc.Client implements IWatchServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public watch(request: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+    public watch(request: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse>;
+}
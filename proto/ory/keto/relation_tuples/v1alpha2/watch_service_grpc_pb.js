@@ -0,0 +1,50 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var ory_keto_relation_tuples_v1alpha2_watch_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/watch_service_pb.js');
+var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_ory_keto_relation_tuples_v1alpha2_WatchRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.WatchRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_WatchRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_WatchResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.WatchResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_WatchResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// The service to follow the changes of relationships.
+//
+// This service is part of the [read-APIs](../concepts/api-overview.mdx#read-apis).
+var WatchServiceService = exports.WatchServiceService = {
+  // Streams every insert and delete of relationships in commit order.
+watch: {
+    path: '/ory.keto.relation_tuples.v1alpha2.WatchService/Watch',
+    requestStream: false,
+    responseStream: true,
+    requestType: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_watch_service_pb.WatchResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_WatchRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_WatchRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_WatchResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_WatchResponse,
+  },
+};
+
+exports.WatchServiceClient = grpc.makeGenericClientConstructor(WatchServiceService);
@@ -0,0 +1,63 @@
+// package: ory.keto.relation_tuples.v1alpha2
+// file: ory/keto/relation_tuples/v1alpha2/watch_service.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as ory_keto_relation_tuples_v1alpha2_write_service_pb from "../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+export class WatchRequest extends jspb.Message { 
+    getCursor(): string;
+    setCursor(value: string): WatchRequest;
+    getNamespace(): string;
+    setNamespace(value: string): WatchRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WatchRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: WatchRequest): WatchRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WatchRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WatchRequest;
+    static deserializeBinaryFromReader(message: WatchRequest, reader: jspb.BinaryReader): WatchRequest;
+}
+
+export namespace WatchRequest {
+    export type AsObject = {
+        cursor: string,
+        namespace: string,
+    }
+}
+
+export class WatchResponse extends jspb.Message { 
+    clearRelationTupleDeltasList(): void;
+    getRelationTupleDeltasList(): Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>;
+    setRelationTupleDeltasList(value: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta>): WatchResponse;
+    addRelationTupleDeltas(value?: ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta, index?: number): ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta;
+    getCursor(): string;
+    setCursor(value: string): WatchResponse;
+
+    hasCommitTime(): boolean;
+    clearCommitTime(): void;
+    getCommitTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCommitTime(value?: google_protobuf_timestamp_pb.Timestamp): WatchResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WatchResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: WatchResponse): WatchResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WatchResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WatchResponse;
+    static deserializeBinaryFromReader(message: WatchResponse, reader: jspb.BinaryReader): WatchResponse;
+}
+
+export namespace WatchResponse {
+    export type AsObject = {
+        relationTupleDeltasList: Array<ory_keto_relation_tuples_v1alpha2_write_service_pb.RelationTupleDelta.AsObject>,
+        cursor: string,
+        commitTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
@@ -22,10 +22,16 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WriteServiceClient interface {
-	// Writes one or more relation tuples in a single transaction.
+	// Writes one or more relationships in a single transaction.
 	TransactRelationTuples(ctx context.Context, in *TransactRelationTuplesRequest, opts ...grpc.CallOption) (*TransactRelationTuplesResponse, error)
-	// Deletes relation tuples based on relation query
+	// Deletes relationships based on relation query
 	DeleteRelationTuples(ctx context.Context, in *DeleteRelationTuplesRequest, opts ...grpc.CallOption) (*DeleteRelationTuplesResponse, error)
+	// Deletes all relationships of an object, and all relationships with a
+	// subject set of the object, in a single transaction.
+	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error)
+	// Moves all relationships of an object, and all relationships with a
+	// subject set of the object, to a new object ID in a single transaction.
+	RenameObject(ctx context.Context, in *RenameObjectRequest, opts ...grpc.CallOption) (*RenameObjectResponse, error)
 }
 
 type writeServiceClient struct {
@@ -54,14 +60,38 @@ func (c *writeServiceClient) DeleteRelationTuples(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *writeServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error) {
+	out := new(DeleteObjectResponse)
+	err := c.cc.Invoke(ctx, "/ory.keto.relation_tuples.v1alpha2.WriteService/DeleteObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *writeServiceClient) RenameObject(ctx context.Context, in *RenameObjectRequest, opts ...grpc.CallOption) (*RenameObjectResponse, error) {
+	out := new(RenameObjectResponse)
+	err := c.cc.Invoke(ctx, "/ory.keto.relation_tuples.v1alpha2.WriteService/RenameObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WriteServiceServer is the server API for WriteService service.
 // All implementations should embed UnimplementedWriteServiceServer
 // for forward compatibility
 type WriteServiceServer interface {
-	// Writes one or more relation tuples in a single transaction.
+	// Writes one or more relationships in a single transaction.
 	TransactRelationTuples(context.Context, *TransactRelationTuplesRequest) (*TransactRelationTuplesResponse, error)
-	// Deletes relation tuples based on relation query
+	// Deletes relationships based on relation query
 	DeleteRelationTuples(context.Context, *DeleteRelationTuplesRequest) (*DeleteRelationTuplesResponse, error)
+	// Deletes all relationships of an object, and all relationships with a
+	// subject set of the object, in a single transaction.
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error)
+	// Moves all relationships of an object, and all relationships with a
+	// subject set of the object, to a new object ID in a single transaction.
+	RenameObject(context.Context, *RenameObjectRequest) (*RenameObjectResponse, error)
 }
 
 // UnimplementedWriteServiceServer should be embedded to have forward compatible implementations.
@@ -74,6 +104,12 @@ func (UnimplementedWriteServiceServer) TransactRelationTuples(context.Context, *
 func (UnimplementedWriteServiceServer) DeleteRelationTuples(context.Context, *DeleteRelationTuplesRequest) (*DeleteRelationTuplesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationTuples not implemented")
 }
+func (UnimplementedWriteServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedWriteServiceServer) RenameObject(context.Context, *RenameObjectRequest) (*RenameObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameObject not implemented")
+}
 
 // UnsafeWriteServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WriteServiceServer will
@@ -122,6 +158,42 @@ func _WriteService_DeleteRelationTuples_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WriteService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WriteServiceServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ory.keto.relation_tuples.v1alpha2.WriteService/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WriteServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WriteService_RenameObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WriteServiceServer).RenameObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ory.keto.relation_tuples.v1alpha2.WriteService/RenameObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WriteServiceServer).RenameObject(ctx, req.(*RenameObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WriteService_ServiceDesc is the grpc.ServiceDesc for WriteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,6 +209,14 @@ var WriteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRelationTuples",
 			Handler:    _WriteService_DeleteRelationTuples_Handler,
 		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _WriteService_DeleteObject_Handler,
+		},
+		{
+			MethodName: "RenameObject",
+			Handler:    _WriteService_RenameObject_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ory/keto/relation_tuples/v1alpha2/write_service.proto",
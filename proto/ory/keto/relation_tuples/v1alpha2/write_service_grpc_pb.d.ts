@@ -11,6 +11,8 @@ import * as ory_keto_relation_tuples_v1alpha2_relation_tuples_pb from "../../../
 interface IWriteServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
     transactRelationTuples: IWriteServiceService_ITransactRelationTuples;
     deleteRelationTuples: IWriteServiceService_IDeleteRelationTuples;
+    deleteObject: IWriteServiceService_IDeleteObject;
+    renameObject: IWriteServiceService_IRenameObject;
 }
 
 interface IWriteServiceService_ITransactRelationTuples extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_write_service_pb.TransactRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.TransactRelationTuplesResponse> {
@@ -31,12 +33,32 @@ interface IWriteServiceService_IDeleteRelationTuples extends grpc.MethodDefiniti
     responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse>;
     responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse>;
 }
+interface IWriteServiceService_IDeleteObject extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.WriteService/DeleteObject";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse>;
+}
+interface IWriteServiceService_IRenameObject extends grpc.MethodDefinition<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse> {
+    path: "/ory.keto.relation_tuples.v1alpha2.WriteService/RenameObject";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest>;
+    requestDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest>;
+    responseSerialize: grpc.serialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse>;
+    responseDeserialize: grpc.deserialize<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse>;
+}
 
 export const WriteServiceService: IWriteServiceService;
 
 export interface IWriteServiceServer {
     transactRelationTuples: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_write_service_pb.TransactRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.TransactRelationTuplesResponse>;
     deleteRelationTuples: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse>;
+    deleteObject: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse>;
+    renameObject: grpc.handleUnaryCall<ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse>;
 }
 
 export interface IWriteServiceClient {
@@ -46,6 +68,12 @@ export interface IWriteServiceClient {
     deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
     deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
     deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
+    deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
+    renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
+    renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WriteServiceClient extends grpc.Client implements IWriteServiceClient {
@@ -56,4 +84,10 @@ export class WriteServiceClient extends grpc.Client implements IWriteServiceClie
     public deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
     public deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
     public deleteRelationTuples(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse) => void): grpc.ClientUnaryCall;
+    public deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    public deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    public deleteObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse) => void): grpc.ClientUnaryCall;
+    public renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
+    public renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
+    public renameObject(request: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse) => void): grpc.ClientUnaryCall;
 }
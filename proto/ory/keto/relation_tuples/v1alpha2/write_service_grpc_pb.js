@@ -5,6 +5,28 @@ var grpc = require('@grpc/grpc-js');
 var ory_keto_relation_tuples_v1alpha2_write_service_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/write_service_pb.js');
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
 
+function serialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_ory_keto_relation_tuples_v1alpha2_DeleteRelationTuplesRequest(arg) {
   if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesRequest)) {
     throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesRequest');
@@ -27,6 +49,28 @@ function deserialize_ory_keto_relation_tuples_v1alpha2_DeleteRelationTuplesRespo
   return ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteRelationTuplesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_ory_keto_relation_tuples_v1alpha2_RenameObjectRequest(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.RenameObjectRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_RenameObjectRequest(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ory_keto_relation_tuples_v1alpha2_RenameObjectResponse(arg) {
+  if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse)) {
+    throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.RenameObjectResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ory_keto_relation_tuples_v1alpha2_RenameObjectResponse(buffer_arg) {
+  return ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_ory_keto_relation_tuples_v1alpha2_TransactRelationTuplesRequest(arg) {
   if (!(arg instanceof ory_keto_relation_tuples_v1alpha2_write_service_pb.TransactRelationTuplesRequest)) {
     throw new Error('Expected argument of type ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest');
@@ -54,7 +98,7 @@ function deserialize_ory_keto_relation_tuples_v1alpha2_TransactRelationTuplesRes
 //
 // This service is part of the [write-APIs](../concepts/api-overview.mdx#write-apis).
 var WriteServiceService = exports.WriteServiceService = {
-  // Writes one or more relation tuples in a single transaction.
+  // Writes one or more relationships in a single transaction.
 transactRelationTuples: {
     path: '/ory.keto.relation_tuples.v1alpha2.WriteService/TransactRelationTuples',
     requestStream: false,
@@ -66,7 +110,7 @@ transactRelationTuples: {
     responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_TransactRelationTuplesResponse,
     responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_TransactRelationTuplesResponse,
   },
-  // Deletes relation tuples based on relation query
+  // Deletes relationships based on relation query
 deleteRelationTuples: {
     path: '/ory.keto.relation_tuples.v1alpha2.WriteService/DeleteRelationTuples',
     requestStream: false,
@@ -78,6 +122,32 @@ deleteRelationTuples: {
     responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_DeleteRelationTuplesResponse,
     responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_DeleteRelationTuplesResponse,
   },
+  // Deletes all relationships of an object, and all relationships with a
+  // subject set of the object, in a single transaction.
+deleteObject: {
+    path: '/ory.keto.relation_tuples.v1alpha2.WriteService/DeleteObject',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_write_service_pb.DeleteObjectResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_DeleteObjectResponse,
+  },
+  // Moves all relationships of an object, and all relationships with a
+  // subject set of the object, to a new object ID in a single transaction.
+renameObject: {
+    path: '/ory.keto.relation_tuples.v1alpha2.WriteService/RenameObject',
+    requestStream: false,
+    responseStream: false,
+    requestType: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectRequest,
+    responseType: ory_keto_relation_tuples_v1alpha2_write_service_pb.RenameObjectResponse,
+    requestSerialize: serialize_ory_keto_relation_tuples_v1alpha2_RenameObjectRequest,
+    requestDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_RenameObjectRequest,
+    responseSerialize: serialize_ory_keto_relation_tuples_v1alpha2_RenameObjectResponse,
+    responseDeserialize: deserialize_ory_keto_relation_tuples_v1alpha2_RenameObjectResponse,
+  },
 };
 
 exports.WriteServiceClient = grpc.makeGenericClientConstructor(WriteServiceService);
@@ -12,6 +12,10 @@ export class TransactRelationTuplesRequest extends jspb.Message {
     getRelationTupleDeltasList(): Array<RelationTupleDelta>;
     setRelationTupleDeltasList(value: Array<RelationTupleDelta>): TransactRelationTuplesRequest;
     addRelationTupleDeltas(value?: RelationTupleDelta, index?: number): RelationTupleDelta;
+    clearPreconditionsList(): void;
+    getPreconditionsList(): Array<Precondition>;
+    setPreconditionsList(value: Array<Precondition>): TransactRelationTuplesRequest;
+    addPreconditions(value?: Precondition, index?: number): Precondition;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): TransactRelationTuplesRequest.AsObject;
@@ -26,9 +30,55 @@ export class TransactRelationTuplesRequest extends jspb.Message {
 export namespace TransactRelationTuplesRequest {
     export type AsObject = {
         relationTupleDeltasList: Array<RelationTupleDelta.AsObject>,
+        preconditionsList: Array<Precondition.AsObject>,
     }
 }
 
+export class Precondition extends jspb.Message { 
+
+    hasTupleExists(): boolean;
+    clearTupleExists(): void;
+    getTupleExists(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple | undefined;
+    setTupleExists(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple): Precondition;
+
+    hasTupleNotExists(): boolean;
+    clearTupleNotExists(): void;
+    getTupleNotExists(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple | undefined;
+    setTupleNotExists(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple): Precondition;
+
+    hasNoMatch(): boolean;
+    clearNoMatch(): void;
+    getNoMatch(): ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery | undefined;
+    setNoMatch(value?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery): Precondition;
+
+    getConditionCase(): Precondition.ConditionCase;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Precondition.AsObject;
+    static toObject(includeInstance: boolean, msg: Precondition): Precondition.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Precondition, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Precondition;
+    static deserializeBinaryFromReader(message: Precondition, reader: jspb.BinaryReader): Precondition;
+}
+
+export namespace Precondition {
+    export type AsObject = {
+        tupleExists?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject,
+        tupleNotExists?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.AsObject,
+        noMatch?: ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery.AsObject,
+    }
+
+    export enum ConditionCase {
+        CONDITION_NOT_SET = 0,
+        TUPLE_EXISTS = 1,
+        TUPLE_NOT_EXISTS = 2,
+        NO_MATCH = 3,
+    }
+
+}
+
 export class RelationTupleDelta extends jspb.Message { 
     getAction(): RelationTupleDelta.Action;
     setAction(value: RelationTupleDelta.Action): RelationTupleDelta;
@@ -163,3 +213,92 @@ export namespace DeleteRelationTuplesResponse {
     export type AsObject = {
     }
 }
+
+export class DeleteObjectRequest extends jspb.Message { 
+    getNamespace(): string;
+    setNamespace(value: string): DeleteObjectRequest;
+    getObject(): string;
+    setObject(value: string): DeleteObjectRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeleteObjectRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DeleteObjectRequest): DeleteObjectRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeleteObjectRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeleteObjectRequest;
+    static deserializeBinaryFromReader(message: DeleteObjectRequest, reader: jspb.BinaryReader): DeleteObjectRequest;
+}
+
+export namespace DeleteObjectRequest {
+    export type AsObject = {
+        namespace: string,
+        object: string,
+    }
+}
+
+export class DeleteObjectResponse extends jspb.Message { 
+    getDeleted(): number;
+    setDeleted(value: number): DeleteObjectResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DeleteObjectResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DeleteObjectResponse): DeleteObjectResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DeleteObjectResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DeleteObjectResponse;
+    static deserializeBinaryFromReader(message: DeleteObjectResponse, reader: jspb.BinaryReader): DeleteObjectResponse;
+}
+
+export namespace DeleteObjectResponse {
+    export type AsObject = {
+        deleted: number,
+    }
+}
+
+export class RenameObjectRequest extends jspb.Message { 
+    getNamespace(): string;
+    setNamespace(value: string): RenameObjectRequest;
+    getObject(): string;
+    setObject(value: string): RenameObjectRequest;
+    getNewObject(): string;
+    setNewObject(value: string): RenameObjectRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RenameObjectRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RenameObjectRequest): RenameObjectRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RenameObjectRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RenameObjectRequest;
+    static deserializeBinaryFromReader(message: RenameObjectRequest, reader: jspb.BinaryReader): RenameObjectRequest;
+}
+
+export namespace RenameObjectRequest {
+    export type AsObject = {
+        namespace: string,
+        object: string,
+        newObject: string,
+    }
+}
+
+export class RenameObjectResponse extends jspb.Message { 
+    getRenamed(): number;
+    setRenamed(value: number): RenameObjectResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RenameObjectResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RenameObjectResponse): RenameObjectResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RenameObjectResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RenameObjectResponse;
+    static deserializeBinaryFromReader(message: RenameObjectResponse, reader: jspb.BinaryReader): RenameObjectResponse;
+}
+
+export namespace RenameObjectResponse {
+    export type AsObject = {
+        renamed: number,
+    }
+}
@@ -23,11 +23,17 @@ var global =
 
 var ory_keto_relation_tuples_v1alpha2_relation_tuples_pb = require('../../../../ory/keto/relation_tuples/v1alpha2/relation_tuples_pb.js');
 goog.object.extend(proto, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesRequest.Query', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesResponse', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.Precondition', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.Precondition.ConditionCase', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest', null, global);
+goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest', null, global);
 goog.exportSymbol('proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse', null, global);
 /**
@@ -51,6 +57,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.Precondition, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.Precondition.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.Precondition';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -156,13 +183,97 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.displayName = 'proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse';
+}
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.repeatedFields_ = [1];
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.repeatedFields_ = [1,2];
 
 
 
@@ -196,7 +307,9 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.
 proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     relationTupleDeltasList: jspb.Message.toObjectList(msg.getRelationTupleDeltasList(),
-    proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject, includeInstance)
+    proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject, includeInstance),
+    preconditionsList: jspb.Message.toObjectList(msg.getPreconditionsList(),
+    proto.ory.keto.relation_tuples.v1alpha2.Precondition.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -238,6 +351,11 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.deserializ
       reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFromReader);
       msg.addRelationTupleDeltas(value);
       break;
+    case 2:
+      var value = new proto.ory.keto.relation_tuples.v1alpha2.Precondition;
+      reader.readMessage(value,proto.ory.keto.relation_tuples.v1alpha2.Precondition.deserializeBinaryFromReader);
+      msg.addPreconditions(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -275,6 +393,14 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.serializeB
       proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWriter
     );
   }
+  f = message.getPreconditionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.ory.keto.relation_tuples.v1alpha2.Precondition.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -316,6 +442,71 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.
 };
 
 
+/**
+ * repeated Precondition preconditions = 2;
+ * @return {!Array<!proto.ory.keto.relation_tuples.v1alpha2.Precondition>}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.getPreconditionsList = function() {
+  return /** @type{!Array<!proto.ory.keto.relation_tuples.v1alpha2.Precondition>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.ory.keto.relation_tuples.v1alpha2.Precondition, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.ory.keto.relation_tuples.v1alpha2.Precondition>} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.setPreconditionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.Precondition=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.addPreconditions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.ory.keto.relation_tuples.v1alpha2.Precondition, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesRequest.prototype.clearPreconditionsList = function() {
+  return this.setPreconditionsList([]);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.ConditionCase = {
+  CONDITION_NOT_SET: 0,
+  TUPLE_EXISTS: 1,
+  TUPLE_NOT_EXISTS: 2,
+  NO_MATCH: 3
+};
+
+/**
+ * @return {proto.ory.keto.relation_tuples.v1alpha2.Precondition.ConditionCase}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.getConditionCase = function() {
+  return /** @type {proto.ory.keto.relation_tuples.v1alpha2.Precondition.ConditionCase} */(jspb.Message.computeOneofCase(this, proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_[0]));
+};
 
 
 
@@ -332,8 +523,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.toObject = function(opt_includeInstance) {
-  return proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject(opt_includeInstance, this);
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.Precondition.toObject(opt_includeInstance, this);
 };
 
 
@@ -342,14 +533,15 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} msg The msg instance to transform.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject = function(includeInstance, msg) {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.toObject = function(includeInstance, msg) {
   var f, obj = {
-    action: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    relationTuple: (f = msg.getRelationTuple()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f)
+    tupleExists: (f = msg.getTupleExists()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f),
+    tupleNotExists: (f = msg.getTupleNotExists()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f),
+    noMatch: (f = msg.getNoMatch()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -363,23 +555,23 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinary = function(bytes) {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta;
-  return proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.Precondition;
+  return proto.ory.keto.relation_tuples.v1alpha2.Precondition.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} msg The message object to deserialize into.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFromReader = function(msg, reader) {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -387,13 +579,19 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFrom
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} */ (reader.readEnum());
-      msg.setAction(value);
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.setTupleExists(value);
       break;
     case 2:
       var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
       reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
-      msg.setRelationTuple(value);
+      msg.setTupleNotExists(value);
+      break;
+    case 3:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery.deserializeBinaryFromReader);
+      msg.setNoMatch(value);
       break;
     default:
       reader.skipField();
@@ -408,9 +606,9 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.serializeBinary = function() {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWriter(this, writer);
+  proto.ory.keto.relation_tuples.v1alpha2.Precondition.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -418,20 +616,21 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} message
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWriter = function(message, writer) {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAction();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getTupleExists();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
     );
   }
-  f = message.getRelationTuple();
+  f = message.getTupleNotExists();
   if (f != null) {
     writer.writeMessage(
       2,
@@ -439,41 +638,59 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWrit
       ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
     );
   }
+  f = message.getNoMatch();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @enum {number}
+ * optional RelationTuple tuple_exists = 1;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action = {
-  ACTION_UNSPECIFIED: 0,
-  ACTION_INSERT: 1,
-  ACTION_DELETE: 2
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.getTupleExists = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 1));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.setTupleExists = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_[0], value);
 };
 
+
 /**
- * optional Action action = 1;
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action}
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.getAction = function() {
-  return /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.clearTupleExists = function() {
+  return this.setTupleExists(undefined);
 };
 
 
 /**
- * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} value
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.setAction = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.hasTupleExists = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional RelationTuple relation_tuple = 2;
+ * optional RelationTuple tuple_not_exists = 2;
  * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.getRelationTuple = function() {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.getTupleNotExists = function() {
   return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple} */ (
     jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 2));
 };
@@ -481,19 +698,19 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.getRelation
 
 /**
  * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple|undefined} value
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
 */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.setRelationTuple = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.setTupleNotExists = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.clearRelationTuple = function() {
-  return this.setRelationTuple(undefined);
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.clearTupleNotExists = function() {
+  return this.setTupleNotExists(undefined);
 };
 
 
@@ -501,18 +718,48 @@ proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.clearRelati
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.hasRelationTuple = function() {
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.hasTupleNotExists = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * optional RelationQuery no_match = 3;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationQuery}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.getNoMatch = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationQuery} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationQuery, 3));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationQuery|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.setNoMatch = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.ory.keto.relation_tuples.v1alpha2.Precondition.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.Precondition} returns this
  */
-proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.repeatedFields_ = [1];
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.clearNoMatch = function() {
+  return this.setNoMatch(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.Precondition.prototype.hasNoMatch = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -529,8 +776,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.toObject(opt_includeInstance, this);
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject(opt_includeInstance, this);
 };
 
 
@@ -539,13 +786,14 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.prototype
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse} msg The msg instance to transform.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.toObject = function(includeInstance, msg) {
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.toObject = function(includeInstance, msg) {
   var f, obj = {
-    snaptokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    action: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    relationTuple: (f = msg.getRelationTuple()) && ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -559,13 +807,209 @@ proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.toObject
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse}
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
  */
-proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.deserializeBinary = function(bytes) {
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse;
-  return proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.deserializeBinaryFromReader(msg, reader);
-};
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta;
+  return proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    case 2:
+      var value = new ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple;
+      reader.readMessage(value,ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.deserializeBinaryFromReader);
+      msg.setRelationTuple(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getRelationTuple();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action = {
+  ACTION_UNSPECIFIED: 0,
+  ACTION_INSERT: 1,
+  ACTION_DELETE: 2
+};
+
+/**
+ * optional Action action = 1;
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.getAction = function() {
+  return /** @type {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.Action} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional RelationTuple relation_tuple = 2;
+ * @return {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.getRelationTuple = function() {
+  return /** @type{?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple} */ (
+    jspb.Message.getWrapperField(this, ory_keto_relation_tuples_v1alpha2_relation_tuples_pb.RelationTuple, 2));
+};
+
+
+/**
+ * @param {?proto.ory.keto.relation_tuples.v1alpha2.RelationTuple|undefined} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+*/
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.setRelationTuple = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.clearRelationTuple = function() {
+  return this.setRelationTuple(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RelationTupleDelta.prototype.hasRelationTuple = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    snaptokensList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.TransactRelationTuplesResponse.deserializeBinaryFromReader(msg, reader);
+};
 
 
 /**
@@ -1206,4 +1650,614 @@ proto.ory.keto.relation_tuples.v1alpha2.DeleteRelationTuplesResponse.serializeBi
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespace: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    object: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setObject(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getObject();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string namespace = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string object = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.getObject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectRequest.prototype.setObject = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deleted: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDeleted(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeleted();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 deleted = 1;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.prototype.getDeleted = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.DeleteObjectResponse.prototype.setDeleted = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespace: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    object: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    newObject: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest;
+  return proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setObject(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNewObject(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getObject();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getNewObject();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string namespace = 1;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string object = 2;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.getObject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.setObject = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string new_object = 3;
+ * @return {string}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.getNewObject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectRequest.prototype.setNewObject = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    renamed: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse;
+  return proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRenamed(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRenamed();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 renamed = 1;
+ * @return {number}
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.prototype.getRenamed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse} returns this
+ */
+proto.ory.keto.relation_tuples.v1alpha2.RenameObjectResponse.prototype.setRenamed = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
 goog.object.extend(exports, proto.ory.keto.relation_tuples.v1alpha2);